@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
@@ -382,6 +383,28 @@ func configOrganizationConformancePackDetailedStatusError(conn *configservice.Co
 	return fmt.Errorf("Failed in %d account(s):\n\n%s", len(memberAccountStatuses), errBuilder.String())
 }
 
+// configOrganizationConformancePackDetailedStatusPendingError returns an error listing the member accounts
+// still in the specified detailed status, e.g. after waiting for the pack to deploy has timed out.
+func configOrganizationConformancePackDetailedStatusPendingError(conn *configservice.ConfigService, name, status string) error {
+	memberAccountStatuses, err := configGetOrganizationConformancePackDetailedStatus(conn, name, status)
+
+	if err != nil {
+		return fmt.Errorf("unable to get Config Organization Conformance Pack detailed status for showing pending member accounts: %w", err)
+	}
+
+	if len(memberAccountStatuses) == 0 {
+		return nil
+	}
+
+	accountIDs := make([]string, 0, len(memberAccountStatuses))
+
+	for _, mas := range memberAccountStatuses {
+		accountIDs = append(accountIDs, aws.StringValue(mas.AccountId))
+	}
+
+	return fmt.Errorf("%s in %d account(s): %s", status, len(accountIDs), strings.Join(accountIDs, ", "))
+}
+
 func configWaitForConformancePackStateCreateComplete(conn *configservice.ConfigService, name string) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.ConformancePackStateCreateInProgress},
@@ -417,11 +440,11 @@ func configWaitForConformancePackStateDeleteComplete(conn *configservice.ConfigS
 	return err
 }
 
-func configWaitForOrganizationConformancePackStatusCreateSuccessful(conn *configservice.ConfigService, name string) error {
+func configWaitForOrganizationConformancePackStatusCreateSuccessful(conn *configservice.ConfigService, name string, timeout time.Duration) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.OrganizationResourceStatusCreateInProgress},
 		Target:  []string{configservice.OrganizationResourceStatusCreateSuccessful},
-		Timeout: ConfigOrganizationConformancePackCreateTimeout,
+		Timeout: timeout,
 		Refresh: configRefreshOrganizationConformancePackCreationStatus(conn, name),
 		// Include a delay to help avoid ResourceDoesNotExist errors
 		Delay: 30 * time.Second,
@@ -429,33 +452,45 @@ func configWaitForOrganizationConformancePackStatusCreateSuccessful(conn *config
 
 	_, err := stateChangeConf.WaitForState()
 
+	if tfresource.TimedOut(err) {
+		tfresource.SetLastError(err, configOrganizationConformancePackDetailedStatusPendingError(conn, name, configservice.OrganizationResourceDetailedStatusCreateInProgress))
+	}
+
 	return err
 
 }
 
-func configWaitForOrganizationConformancePackStatusUpdateSuccessful(conn *configservice.ConfigService, name string) error {
+func configWaitForOrganizationConformancePackStatusUpdateSuccessful(conn *configservice.ConfigService, name string, timeout time.Duration) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.OrganizationResourceStatusUpdateInProgress},
 		Target:  []string{configservice.OrganizationResourceStatusUpdateSuccessful},
-		Timeout: ConfigOrganizationConformancePackUpdateTimeout,
+		Timeout: timeout,
 		Refresh: configRefreshOrganizationConformancePackStatus(conn, name),
 	}
 
 	_, err := stateChangeConf.WaitForState()
 
+	if tfresource.TimedOut(err) {
+		tfresource.SetLastError(err, configOrganizationConformancePackDetailedStatusPendingError(conn, name, configservice.OrganizationResourceDetailedStatusUpdateInProgress))
+	}
+
 	return err
 }
 
-func configWaitForOrganizationConformancePackStatusDeleteSuccessful(conn *configservice.ConfigService, name string) error {
+func configWaitForOrganizationConformancePackStatusDeleteSuccessful(conn *configservice.ConfigService, name string, timeout time.Duration) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.OrganizationResourceStatusDeleteInProgress},
 		Target:  []string{configservice.OrganizationResourceStatusDeleteSuccessful},
-		Timeout: ConfigOrganizationConformancePackDeleteTimeout,
+		Timeout: timeout,
 		Refresh: configRefreshOrganizationConformancePackStatus(conn, name),
 	}
 
 	_, err := stateChangeConf.WaitForState()
 
+	if tfresource.TimedOut(err) {
+		tfresource.SetLastError(err, configOrganizationConformancePackDetailedStatusPendingError(conn, name, configservice.OrganizationResourceDetailedStatusDeleteInProgress))
+	}
+
 	return err
 }
 
@@ -0,0 +1,584 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+const (
+	// Maximum number of dimensions per metric.
+	cloudWatchDashboardDocumentMaxDimensions = 30
+)
+
+func dataSourceAwsCloudWatchDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudWatchDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start"},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateArn,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp"}, false),
+									},
+									"states": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"color": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a six digit hex color, e.g. #1f77b4"),
+												},
+												"dimensions": {
+													Type:         schema.TypeMap,
+													Optional:     true,
+													Elem:         &schema.Schema{Type: schema.TypeString},
+													ValidateFunc: validateCloudWatchDashboardDocumentDimensions,
+												},
+												"expression": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`), "must start with a lowercase letter and contain only letters, numbers and underscores"),
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"namespace": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"period": {
+													Type:     schema.TypeInt,
+													Optional: true,
+													ValidateFunc: validation.Any(
+														validation.IntInSlice([]int{1, 5, 10, 30}),
+														validation.IntDivisibleBy(60),
+													),
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"left", "right"}, false),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"bar", "gauge", "pie", "singleValue", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 24),
+						},
+						"x": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"y": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// cloudWatchDashboardDocument is the dashboard body structure.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+type cloudWatchDashboardDocument struct {
+	Start          string                       `json:"start,omitempty"`
+	End            string                       `json:"end,omitempty"`
+	PeriodOverride string                       `json:"periodOverride,omitempty"`
+	Widgets        []*cloudWatchDashboardWidget `json:"widgets"`
+}
+
+type cloudWatchDashboardWidget struct {
+	Type       string                 `json:"type"`
+	X          *int                   `json:"x,omitempty"`
+	Y          *int                   `json:"y,omitempty"`
+	Width      *int                   `json:"width,omitempty"`
+	Height     *int                   `json:"height,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+func dataSourceAwsCloudWatchDashboardDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := &cloudWatchDashboardDocument{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+		Widgets:        []*cloudWatchDashboardWidget{},
+	}
+
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		widget, err := expandCloudWatchDashboardWidget(tfMap)
+
+		if err != nil {
+			return fmt.Errorf("widget %d: %w", i, err)
+		}
+
+		doc.Widgets = append(doc.Widgets, widget)
+	}
+
+	var jsonDoc []byte
+	var err error
+	if d.Get("minify").(bool) {
+		jsonDoc, err = json.Marshal(doc)
+	} else {
+		jsonDoc, err = json.MarshalIndent(doc, "", "  ")
+	}
+	if err != nil {
+		// should never happen if the above code is correct
+		return err
+	}
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
+
+	return nil
+}
+
+func expandCloudWatchDashboardWidget(tfMap map[string]interface{}) (*cloudWatchDashboardWidget, error) {
+	widget := &cloudWatchDashboardWidget{}
+
+	// Omitted positions and sizes are laid out by CloudWatch.
+	if v, ok := tfMap["height"].(int); ok && v > 0 {
+		widget.Height = &v
+	}
+
+	if v, ok := tfMap["width"].(int); ok && v > 0 {
+		widget.Width = &v
+	}
+
+	// CloudWatch requires both coordinates when either is specified.
+	if x, y := tfMap["x"].(int), tfMap["y"].(int); x > 0 || y > 0 {
+		widget.X = &x
+		widget.Y = &y
+	}
+
+	var types []string
+
+	if v, ok := tfMap["alarm"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		types = append(types, "alarm")
+		widget.Type = "alarm"
+		widget.Properties = expandCloudWatchDashboardAlarmWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		types = append(types, "log")
+		widget.Type = "log"
+		widget.Properties = expandCloudWatchDashboardLogWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		types = append(types, "metric")
+		widget.Type = "metric"
+
+		properties, err := expandCloudWatchDashboardMetricWidgetProperties(v[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		widget.Properties = properties
+	}
+
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		types = append(types, "text")
+		widget.Type = "text"
+		widget.Properties = expandCloudWatchDashboardTextWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if len(types) != 1 {
+		return nil, fmt.Errorf("exactly one of alarm, log, metric or text must be specified, got %d", len(types))
+	}
+
+	return widget, nil
+}
+
+func expandCloudWatchDashboardAlarmWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"alarms": tfMap["alarms"].([]interface{}),
+	}
+
+	if v, ok := tfMap["sort_by"].(string); ok && v != "" {
+		properties["sortBy"] = v
+	}
+
+	if v, ok := tfMap["states"].([]interface{}); ok && len(v) > 0 {
+		properties["states"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	return properties
+}
+
+func expandCloudWatchDashboardLogWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"query":  tfMap["query"].(string),
+		"region": tfMap["region"].(string),
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		properties["stacked"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		properties["view"] = v
+	}
+
+	return properties
+}
+
+func expandCloudWatchDashboardMetricWidgetProperties(tfMap map[string]interface{}) (map[string]interface{}, error) {
+	properties := map[string]interface{}{
+		"region": tfMap["region"].(string),
+	}
+
+	var metrics []interface{}
+
+	for i, metricRaw := range tfMap["metric"].([]interface{}) {
+		metric, ok := metricRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, err := expandCloudWatchDashboardMetric(metric)
+
+		if err != nil {
+			return nil, fmt.Errorf("metric %d: %w", i, err)
+		}
+
+		metrics = append(metrics, v)
+	}
+
+	properties["metrics"] = metrics
+
+	if v, ok := tfMap["period"].(int); ok && v > 0 {
+		properties["period"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		properties["stacked"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		properties["stat"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		properties["view"] = v
+	}
+
+	return properties, nil
+}
+
+// expandCloudWatchDashboardMetric returns a metric widget array entry:
+// [namespace, metric_name, dimension name, dimension value, ..., {rendering properties}],
+// or [{expression, rendering properties}] for metric math.
+func expandCloudWatchDashboardMetric(tfMap map[string]interface{}) ([]interface{}, error) {
+	expression := tfMap["expression"].(string)
+	metricName := tfMap["metric_name"].(string)
+	namespace := tfMap["namespace"].(string)
+	dimensions := tfMap["dimensions"].(map[string]interface{})
+
+	if expression != "" && (metricName != "" || namespace != "" || len(dimensions) > 0) {
+		return nil, fmt.Errorf("expression cannot be combined with namespace, metric_name or dimensions")
+	}
+
+	if expression == "" && (metricName == "" || namespace == "") {
+		return nil, fmt.Errorf("either expression or both namespace and metric_name must be specified")
+	}
+
+	options := map[string]interface{}{}
+
+	if expression != "" {
+		options["expression"] = expression
+	}
+
+	if v, ok := tfMap["color"].(string); ok && v != "" {
+		options["color"] = v
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		options["id"] = v
+	}
+
+	if v, ok := tfMap["label"].(string); ok && v != "" {
+		options["label"] = v
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v > 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		options["stat"] = v
+	}
+
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options["visible"] = v
+	}
+
+	if v, ok := tfMap["y_axis"].(string); ok && v != "" {
+		options["yAxis"] = v
+	}
+
+	if expression != "" {
+		return []interface{}{options}, nil
+	}
+
+	metric := []interface{}{namespace, metricName}
+
+	// Sort dimensions so that the rendered document is stable.
+	names := make([]string, 0, len(dimensions))
+	for name := range dimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		metric = append(metric, name, dimensions[name].(string))
+	}
+
+	if len(options) > 0 {
+		metric = append(metric, options)
+	}
+
+	return metric, nil
+}
+
+func expandCloudWatchDashboardTextWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"markdown": tfMap["markdown"].(string),
+	}
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		properties["background"] = v
+	}
+
+	return properties
+}
+
+func validateCloudWatchDashboardDocumentDimensions(v interface{}, k string) (ws []string, errors []error) {
+	dimensions := v.(map[string]interface{})
+
+	if len(dimensions) > cloudWatchDashboardDocumentMaxDimensions {
+		errors = append(errors, fmt.Errorf("%q cannot contain more than %d dimensions", k, cloudWatchDashboardDocumentMaxDimensions))
+	}
+
+	for name, value := range dimensions {
+		if len(name) < 1 || len(name) > 255 {
+			errors = append(errors, fmt.Errorf("%q dimension name (%s) must be between 1 and 255 characters", k, name))
+		}
+
+		if s, ok := value.(string); ok && (len(s) < 1 || len(s) > 1024) {
+			errors = append(errors, fmt.Errorf("%q dimension %s value must be between 1 and 1024 characters", k, name))
+		}
+	}
+
+	return
+}
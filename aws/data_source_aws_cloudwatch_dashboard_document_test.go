@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceCloudWatchDashboardDocument_basic(t *testing.T) {
+	// This really ought to be able to be a unit test rather than an
+	// acceptance test, but just instantiating the AWS provider requires
+	// some AWS API calls, and so this needs valid AWS credentials to work.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchDashboardDocumentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_cloudwatch_dashboard_document.test", "json",
+						testAccAWSCloudWatchDashboardDocumentExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceCloudWatchDashboardDocument_expressionConflict(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchDashboardDocumentConfigExpressionConflict,
+				ExpectError: regexp.MustCompile(`expression cannot be combined with namespace, metric_name or dimensions`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceCloudWatchDashboardDocument_multipleWidgetTypes(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchDashboardDocumentConfigMultipleWidgetTypes,
+				ExpectError: regexp.MustCompile(`exactly one of alarm, log, metric or text must be specified`),
+			},
+		},
+	})
+}
+
+const testAccAWSCloudWatchDashboardDocumentConfig = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  minify          = true
+  start           = "-PT6H"
+  period_override = "inherit"
+
+  widget {
+    width  = 12
+    height = 6
+
+    metric {
+      region = "us-west-2"
+      title  = "Invocations"
+      stat   = "Sum"
+      period = 300
+
+      metric {
+        namespace   = "AWS/Lambda"
+        metric_name = "Invocations"
+        id          = "invocations"
+
+        dimensions = {
+          Resource     = "test:prod"
+          FunctionName = "test"
+        }
+      }
+
+      metric {
+        namespace   = "AWS/Lambda"
+        metric_name = "Errors"
+        id          = "errors"
+        visible     = false
+
+        dimensions = {
+          FunctionName = "test"
+        }
+      }
+
+      metric {
+        expression = "100 * errors / invocations"
+        id         = "error_rate"
+        label      = "Error rate"
+        y_axis     = "right"
+      }
+    }
+  }
+
+  widget {
+    x      = 12
+    width  = 12
+    height = 6
+
+    text {
+      markdown = "# Hello"
+    }
+  }
+
+  widget {
+    y      = 6
+    width  = 24
+    height = 3
+
+    alarm {
+      title  = "Alarms"
+      alarms = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"]
+      states = ["ALARM"]
+    }
+  }
+
+  widget {
+    y = 9
+
+    log {
+      region = "us-west-2"
+      query  = "SOURCE '/aws/lambda/test' | fields @timestamp, @message"
+      view   = "table"
+    }
+  }
+}
+`
+
+var testAccAWSCloudWatchDashboardDocumentExpectedJSON = `{"start":"-PT6H","periodOverride":"inherit","widgets":[` +
+	`{"type":"metric","width":12,"height":6,"properties":{"metrics":[` +
+	`["AWS/Lambda","Invocations","FunctionName","test","Resource","test:prod",{"id":"invocations"}],` +
+	`["AWS/Lambda","Errors","FunctionName","test",{"id":"errors","visible":false}],` +
+	`[{"expression":"100 * errors / invocations","id":"error_rate","label":"Error rate","yAxis":"right"}]],` +
+	`"period":300,"region":"us-west-2","stat":"Sum","title":"Invocations"}},` +
+	`{"type":"text","x":12,"y":0,"width":12,"height":6,"properties":{"markdown":"# Hello"}},` +
+	`{"type":"alarm","x":0,"y":6,"width":24,"height":3,"properties":{"alarms":["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"],"states":["ALARM"],"title":"Alarms"}},` +
+	`{"type":"log","x":0,"y":9,"properties":{"query":"SOURCE '/aws/lambda/test' | fields @timestamp, @message","region":"us-west-2","view":"table"}}]}`
+
+const testAccAWSCloudWatchDashboardDocumentConfigExpressionConflict = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      region = "us-west-2"
+
+      metric {
+        namespace  = "AWS/Lambda"
+        expression = "SUM(METRICS())"
+      }
+    }
+  }
+}
+`
+
+const testAccAWSCloudWatchDashboardDocumentConfigMultipleWidgetTypes = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "# Hello"
+    }
+
+    alarm {
+      alarms = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"]
+    }
+  }
+}
+`
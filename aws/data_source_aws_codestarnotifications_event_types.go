@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsCodeStarNotificationsEventTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCodeStarNotificationsEventTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_type_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_type_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"service_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func dataSourceAwsCodeStarNotificationsEventTypesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarnotificationsconn
+
+	input := &codestarnotifications.ListEventTypesInput{}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.Filters = append(input.Filters, &codestarnotifications.ListEventTypesFilter{
+			Name:  aws.String(codestarnotifications.ListEventTypesFilterNameResourceType),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("service_name"); ok {
+		input.Filters = append(input.Filters, &codestarnotifications.ListEventTypesFilter{
+			Name:  aws.String(codestarnotifications.ListEventTypesFilterNameServiceName),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	var eventTypeIDs []string
+	var eventTypes []interface{}
+
+	err := conn.ListEventTypesPages(input, func(page *codestarnotifications.ListEventTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, eventType := range page.EventTypes {
+			if eventType == nil {
+				continue
+			}
+
+			eventTypeIDs = append(eventTypeIDs, aws.StringValue(eventType.EventTypeId))
+			eventTypes = append(eventTypes, map[string]interface{}{
+				"event_type_id":   aws.StringValue(eventType.EventTypeId),
+				"event_type_name": aws.StringValue(eventType.EventTypeName),
+				"resource_type":   aws.StringValue(eventType.ResourceType),
+				"service_name":    aws.StringValue(eventType.ServiceName),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing CodeStar Notifications Event Types: %w", err)
+	}
+
+	if err := d.Set("event_type_ids", eventTypeIDs); err != nil {
+		return fmt.Errorf("error setting event_type_ids: %w", err)
+	}
+
+	if err := d.Set("event_types", eventTypes); err != nil {
+		return fmt.Errorf("error setting event_types: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsCodeStarNotificationsEventTypes_basic(t *testing.T) {
+	dataSourceName := "data.aws_codestarnotifications_event_types.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarnotifications.EndpointsID, t) },
+		ErrorCheck: testAccErrorCheck(t, codestarnotifications.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsCodeStarNotificationsEventTypesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "event_type_ids.*", "codecommit-repository-comments-on-commits"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "event_types.*", map[string]string{
+						"event_type_id": "codecommit-repository-comments-on-commits",
+						"resource_type": "Repository",
+						"service_name":  "CodeCommit",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsCodeStarNotificationsEventTypesConfig = `
+data "aws_codestarnotifications_event_types" "test" {
+  service_name = "CodeCommit"
+}
+`
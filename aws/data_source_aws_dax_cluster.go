@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dax/finder"
+)
+
+func dataSourceAwsDaxCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDaxClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_endpoint_encryption_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"configuration_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"maintenance_window": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"replication_factor": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).daxconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("cluster_name").(string)
+	cluster, err := finder.ClusterByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading DAX Cluster (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(cluster.ClusterName))
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("cluster_endpoint_encryption_type", cluster.ClusterEndpointEncryptionType)
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("description", cluster.Description)
+	d.Set("iam_role_arn", cluster.IamRoleArn)
+	d.Set("maintenance_window", cluster.PreferredMaintenanceWindow)
+	d.Set("node_type", cluster.NodeType)
+	if cluster.ParameterGroup != nil {
+		d.Set("parameter_group_name", cluster.ParameterGroup.ParameterGroupName)
+	}
+	d.Set("replication_factor", cluster.TotalNodes)
+	d.Set("security_group_ids", flattenDaxSecurityGroupIds(cluster.SecurityGroups))
+	d.Set("status", cluster.Status)
+	d.Set("subnet_group_name", cluster.SubnetGroup)
+
+	if cluster.ClusterDiscoveryEndpoint != nil {
+		d.Set("cluster_address", cluster.ClusterDiscoveryEndpoint.Address)
+		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.StringValue(cluster.ClusterDiscoveryEndpoint.Address), aws.Int64Value(cluster.ClusterDiscoveryEndpoint.Port)))
+		d.Set("port", cluster.ClusterDiscoveryEndpoint.Port)
+	}
+
+	if err := setDaxClusterNodeData(d, cluster); err != nil {
+		return fmt.Errorf("error setting nodes: %w", err)
+	}
+
+	tags, err := keyvaluetags.DaxListTags(conn, aws.StringValue(cluster.ClusterArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DAX Cluster (%s): %w", name, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsDaxCluster_basic(t *testing.T) {
+	rString := acctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+	dataSourceName := "data.aws_dax_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccPreCheckAWSDax(t) },
+		ErrorCheck: testAccErrorCheck(t, dax.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsDaxClusterConfig(rString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_address", resourceName, "cluster_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_endpoint_encryption_type", resourceName, "cluster_endpoint_encryption_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration_endpoint", resourceName, "configuration_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_role_arn", resourceName, "iam_role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "node_type", resourceName, "node_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.#", resourceName, "nodes.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.0.address", resourceName, "nodes.0.address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "port", resourceName, "port"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "replication_factor", resourceName, "replication_factor"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsDaxClusterConfig(rString string) string {
+	return composeConfig(testAccAWSDAXClusterConfig(rString), `
+data "aws_dax_cluster" "test" {
+  cluster_name = aws_dax_cluster.test.cluster_name
+}
+`)
+}
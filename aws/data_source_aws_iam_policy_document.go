@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -15,6 +16,21 @@ import (
 
 var dataSourceAwsIamPolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+// Maximum policy document sizes, in characters, by size_limit_type.
+// IAM inline policy limits apply to the aggregate size of all of an identity's inline policies,
+// so they are only upper bounds for a single document.
+var dataSourceAwsIamPolicyDocumentSizeLimits = map[string]int{
+	"group_inline":           5120,
+	"managed_policy":         6144,
+	"role_inline":            10240,
+	"service_control_policy": 5120,
+	"user_inline":            2048,
+}
+
+// Resource-based policy size limits vary by service, so this size_limit_type
+// has no limit of its own and requires an explicit size_limit instead.
+const dataSourceAwsIamPolicyDocumentSizeLimitTypeResourcePolicy = "resource_policy"
+
 func dataSourceAwsIamPolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -32,6 +48,11 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"override_json": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -45,6 +66,24 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of characters in the minified policy document, even when minify is false.",
+			},
+			"size_limit": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"size_limit_type"},
+			},
+			"size_limit_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Type of policy whose size limit the document must fit within. Inline policy limits are totals across all inline policies of the principal.",
+				ValidateFunc:  validation.StringInSlice(dataSourceAwsIamPolicyDocumentSizeLimitTypes(), false),
+				ConflictsWith: []string{"size_limit"},
+			},
 			"source_json": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -267,19 +306,57 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		mergedDoc.Merge(overrideDoc)
 	}
 
-	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
+	compactDoc, err := json.Marshal(mergedDoc)
 	if err != nil {
 		// should never happen if the above code is correct
 		return err
 	}
+
+	jsonDoc := compactDoc
+	if !d.Get("minify").(bool) {
+		jsonDoc, err = json.MarshalIndent(mergedDoc, "", "  ")
+		if err != nil {
+			return err
+		}
+	}
 	jsonString := string(jsonDoc)
 
+	// AWS does not count whitespace towards policy size limits.
+	size := len(compactDoc)
+
+	sizeLimit := d.Get("size_limit").(int)
+	if v, ok := d.GetOk("size_limit_type"); ok {
+		if v.(string) == dataSourceAwsIamPolicyDocumentSizeLimitTypeResourcePolicy {
+			return fmt.Errorf("size_limit_type %q: resource-based policy size limits vary by service, set size_limit to the limit of the target service instead", v.(string))
+		}
+
+		sizeLimit = dataSourceAwsIamPolicyDocumentSizeLimits[v.(string)]
+	}
+
+	if sizeLimit > 0 && size > sizeLimit {
+		return fmt.Errorf("policy document size (%d characters) exceeds limit (%d characters)", size, sizeLimit)
+	}
+
 	d.Set("json", jsonString)
+	d.Set("size", size)
 	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
 
 	return nil
 }
 
+func dataSourceAwsIamPolicyDocumentSizeLimitTypes() []string {
+	limitTypes := make([]string, 0, len(dataSourceAwsIamPolicyDocumentSizeLimits)+1)
+	limitTypes = append(limitTypes, dataSourceAwsIamPolicyDocumentSizeLimitTypeResourcePolicy)
+
+	for k := range dataSourceAwsIamPolicyDocumentSizeLimits {
+		limitTypes = append(limitTypes, k)
+	}
+
+	sort.Strings(limitTypes)
+
+	return limitTypes
+}
+
 func dataSourceAwsIamPolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
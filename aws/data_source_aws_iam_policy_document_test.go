@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -296,6 +297,55 @@ func TestAccAWSDataSourceIAMPolicyDocument_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_minify(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, iam.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentDataSourceConfigMinify,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccAWSIAMPolicyDocumentDataSourceConfigMinifyExpectedJSON),
+					resource.TestCheckResourceAttr(dataSourceName, "size", strconv.Itoa(len(testAccAWSIAMPolicyDocumentDataSourceConfigMinifyExpectedJSON))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_sizeLimit(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, iam.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentDataSourceConfigSizeLimit(10),
+				ExpectError: regexp.MustCompile(`policy document size \(105 characters\) exceeds limit \(10 characters\)`),
+			},
+			{
+				Config: testAccAWSIAMPolicyDocumentDataSourceConfigSizeLimit(105),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "size", "105"),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyDocumentDataSourceConfigSizeLimitType("user_inline"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "size", "105"),
+				),
+			},
+			{
+				Config:      testAccAWSIAMPolicyDocumentDataSourceConfigSizeLimitType("resource_policy"),
+				ExpectError: regexp.MustCompile(`set size_limit to the limit of the target service`),
+			},
+		},
+	})
+}
+
 var testAccAWSIAMPolicyDocumentConfig = `
 data "aws_partition" "current" {}
 
@@ -1258,3 +1308,42 @@ func testAccAWSIAMPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultipl
   ]
 }`, testAccGetPartition())
 }
+
+const testAccAWSIAMPolicyDocumentDataSourceConfigMinify = `
+data "aws_iam_policy_document" "test" {
+  minify = true
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`
+
+const testAccAWSIAMPolicyDocumentDataSourceConfigMinifyExpectedJSON = `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+
+func testAccAWSIAMPolicyDocumentDataSourceConfigSizeLimit(sizeLimit int) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  size_limit = %[1]d
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`, sizeLimit)
+}
+
+func testAccAWSIAMPolicyDocumentDataSourceConfigSizeLimitType(sizeLimitType string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  size_limit_type = %[1]q
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+`, sizeLimitType)
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsMqBrokerEngineTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMqBrokerEngineTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"broker_engine_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(mq.EngineType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceAwsMqBrokerEngineTypesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mqconn
+
+	input := &mq.DescribeBrokerEngineTypesInput{}
+
+	if v, ok := d.GetOk("engine_type"); ok {
+		input.EngineType = aws.String(v.(string))
+	}
+
+	var engineTypes []*mq.BrokerEngineType
+
+	for {
+		output, err := conn.DescribeBrokerEngineTypes(input)
+
+		if err != nil {
+			return fmt.Errorf("error reading MQ Broker Engine Types: %w", err)
+		}
+
+		if output == nil {
+			break
+		}
+
+		engineTypes = append(engineTypes, output.BrokerEngineTypes...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("broker_engine_types", flattenMqBrokerEngineTypes(engineTypes)); err != nil {
+		return fmt.Errorf("error setting broker_engine_types: %w", err)
+	}
+
+	return nil
+}
+
+func flattenMqBrokerEngineTypes(apiObjects []*mq.BrokerEngineType) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var versions []interface{}
+
+		for _, version := range apiObject.EngineVersions {
+			if version == nil {
+				continue
+			}
+
+			versions = append(versions, map[string]interface{}{
+				"name": aws.StringValue(version.Name),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"engine_type":     aws.StringValue(apiObject.EngineType),
+			"engine_versions": versions,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsMqBrokerEngineTypes_basic(t *testing.T) {
+	dataSourceName := "data.aws_mq_broker_engine_types.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mq.EndpointsID, t) },
+		ErrorCheck: testAccErrorCheck(t, mq.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsMqBrokerEngineTypesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "broker_engine_types.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "broker_engine_types.0.engine_type", mq.EngineTypeActivemq),
+					testCheckResourceAttrGreaterThanValue(dataSourceName, "broker_engine_types.0.engine_versions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsMqBrokerEngineTypesConfig = `
+data "aws_mq_broker_engine_types" "test" {
+  engine_type = "ACTIVEMQ"
+}
+`
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsOrganizationsEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsOrganizationsEffectivePolicyRead,
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(organizations.EffectivePolicyType_Values(), false),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsEffectivePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).organizationsconn
+
+	policyType := d.Get("policy_type").(string)
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: aws.String(policyType),
+	}
+
+	if v, ok := d.GetOk("target_id"); ok {
+		input.TargetId = aws.String(v.(string))
+	}
+
+	output, err := conn.DescribeEffectivePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error describing Organizations effective %s: %w", policyType, err))
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return diag.FromErr(fmt.Errorf("error describing Organizations effective %s: empty response", policyType))
+	}
+
+	policy := output.EffectivePolicy
+	targetID := aws.StringValue(policy.TargetId)
+
+	d.SetId(fmt.Sprintf("%s,%s", targetID, policyType))
+	d.Set("content", policy.PolicyContent)
+	if policy.LastUpdatedTimestamp != nil {
+		d.Set("last_updated_timestamp", aws.TimeValue(policy.LastUpdatedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_timestamp", nil)
+	}
+	d.Set("policy_type", policy.PolicyType)
+	d.Set("target_id", targetID)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsEffectivePolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_organizations_effective_policy.test"
+	tagPolicyContent := `{ "tags": { "Product": { "tag_key": { "@@assign": "Product" }, "enforced_for": { "@@assign": [ "ec2:instance" ] } } } }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ErrorCheck: testAccErrorCheck(t, organizations.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsEffectivePolicyConfig(rName, tagPolicyContent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`"Product"`)),
+					testAccCheckResourceAttrRfc3339(dataSourceName, "last_updated_timestamp"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", organizations.EffectivePolicyTypeTagPolicy),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_id", "aws_organizations_organization.test", "master_account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsEffectivePolicyConfig(rName, policyContent string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["TAG_POLICY"]
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  name    = %[1]q
+  type    = "TAG_POLICY"
+  content = %[2]s
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organization.test.master_account_id
+}
+
+data "aws_organizations_effective_policy" "test" {
+  policy_type = "TAG_POLICY"
+  target_id   = aws_organizations_policy_attachment.test.target_id
+}
+`, rName, strconv.Quote(policyContent))
+}
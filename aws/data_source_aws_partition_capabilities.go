@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsPartitionCapabilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsPartitionCapabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"partition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"service_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsPartitionCapabilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	region := meta.(*AWSClient).region
+
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	if !ok {
+		return fmt.Errorf("unable to determine partition for region (%s)", region)
+	}
+
+	var serviceIDs []string
+
+	for serviceID, service := range partition.Services() {
+		if servicePartitionEndpointsContainRegion(service, region) {
+			serviceIDs = append(serviceIDs, serviceID)
+		}
+	}
+
+	d.SetId(region)
+	d.Set("dns_suffix", partition.DNSSuffix())
+	d.Set("partition", partition.ID())
+	d.Set("region", region)
+	d.Set("service_ids", serviceIDs)
+
+	return nil
+}
+
+// servicePartitionEndpointsContainRegion returns whether the endpoints metadata has an endpoint for the service in the region.
+// Global services, which have a single partition endpoint, are available in every region of their partition.
+func servicePartitionEndpointsContainRegion(service endpoints.Service, region string) bool {
+	for id := range service.Endpoints() {
+		if id == region || strings.HasSuffix(id, "-global") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsPartitionCapabilities_basic(t *testing.T) {
+	dataSourceName := "data.aws_partition_capabilities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsPartitionCapabilitiesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "dns_suffix", testAccGetPartitionDNSSuffix()),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", testAccGetPartition()),
+					resource.TestCheckResourceAttr(dataSourceName, "region", testAccGetRegion()),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "service_ids.*", "ec2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "service_ids.*", "iam"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsPartitionCapabilities_Region(t *testing.T) {
+	dataSourceName := "data.aws_partition_capabilities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsPartitionCapabilitiesConfigRegion("cn-north-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "dns_suffix", "amazonaws.com.cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws-cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", "cn-north-1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "service_ids.*", "ec2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsPartitionCapabilitiesConfig = `
+data "aws_partition_capabilities" "test" {}
+`
+
+func testAccDataSourceAwsPartitionCapabilitiesConfigRegion(region string) string {
+	return fmt.Sprintf(`
+data "aws_partition_capabilities" "test" {
+  region = %[1]q
+}
+`, region)
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsResourceGroupsGroupResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsResourceGroupsGroupResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsResourceGroupsGroupResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupsconn
+
+	groupName := d.Get("group_name").(string)
+	input := &resourcegroups.ListGroupResourcesInput{
+		Group: aws.String(groupName),
+	}
+
+	var resourceArns []string
+	var resources []interface{}
+
+	err := conn.ListGroupResourcesPages(input, func(page *resourcegroups.ListGroupResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, identifier := range page.ResourceIdentifiers {
+			if identifier == nil {
+				continue
+			}
+
+			resourceArns = append(resourceArns, aws.StringValue(identifier.ResourceArn))
+			resources = append(resources, map[string]interface{}{
+				"arn":  aws.StringValue(identifier.ResourceArn),
+				"type": aws.StringValue(identifier.ResourceType),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing resources for resource group (%s): %w", groupName, err)
+	}
+
+	d.SetId(groupName)
+
+	if err := d.Set("resource_arns", resourceArns); err != nil {
+		return fmt.Errorf("error setting resource_arns: %w", err)
+	}
+
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("error setting resources: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsResourceGroupsGroupResources_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_resourcegroups_group_resources.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, resourcegroups.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsResourceGroupsGroupResourcesConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_arns.0", vpcResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.arn", vpcResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "AWS::EC2::VPC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsResourceGroupsGroupResourcesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  resource_query {
+    query = jsonencode({
+      ResourceTypeFilters = ["AWS::EC2::VPC"]
+      TagFilters = [{
+        Key    = "Name"
+        Values = [%[1]q]
+      }]
+    })
+  }
+}
+
+data "aws_resourcegroups_group_resources" "test" {
+  group_name = aws_resourcegroups_group.test.name
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsServicePrincipal() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsServicePrincipalRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsServicePrincipalRead(d *schema.ResourceData, meta interface{}) error {
+	region := meta.(*AWSClient).region
+
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	serviceName := d.Get("service_name").(string)
+	suffix, err := servicePrincipalSuffix(serviceName, region)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s.%s.%s", serviceName, region, suffix))
+	d.Set("name", fmt.Sprintf("%s.%s", serviceName, suffix))
+	d.Set("region", region)
+	d.Set("suffix", suffix)
+
+	return nil
+}
+
+// servicePrincipalSuffix returns the DNS suffix of the service principal for the given service in the given region.
+func servicePrincipalSuffix(serviceName, region string) (string, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	if !ok {
+		return "", fmt.Errorf("unable to determine partition for region (%s)", region)
+	}
+
+	switch partition.ID() {
+	case endpoints.AwsCnPartitionID:
+		// Only a few services use the partition DNS suffix in China; the rest keep the global suffix.
+		switch serviceName {
+		case "codedeploy", "elasticmapreduce", "logs":
+			return partition.DNSSuffix(), nil
+		}
+		return "amazonaws.com", nil
+	case endpoints.AwsIsoPartitionID, endpoints.AwsIsoBPartitionID:
+		return partition.DNSSuffix(), nil
+	default:
+		return "amazonaws.com", nil
+	}
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestServicePrincipalSuffix(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		ServiceName    string
+		Region         string
+		ExpectedSuffix string
+		ExpectError    bool
+	}{
+		{
+			TestName:       "commercial",
+			ServiceName:    "logs",
+			Region:         "us-east-1",
+			ExpectedSuffix: "amazonaws.com",
+		},
+		{
+			TestName:       "GovCloud",
+			ServiceName:    "logs",
+			Region:         "us-gov-west-1",
+			ExpectedSuffix: "amazonaws.com",
+		},
+		{
+			TestName:       "China partition suffix",
+			ServiceName:    "logs",
+			Region:         "cn-north-1",
+			ExpectedSuffix: "amazonaws.com.cn",
+		},
+		{
+			TestName:       "China global suffix",
+			ServiceName:    "ec2",
+			Region:         "cn-northwest-1",
+			ExpectedSuffix: "amazonaws.com",
+		},
+		{
+			TestName:       "ISO",
+			ServiceName:    "ec2",
+			Region:         "us-iso-east-1",
+			ExpectedSuffix: "c2s.ic.gov",
+		},
+		{
+			TestName:       "ISOB",
+			ServiceName:    "ec2",
+			Region:         "us-isob-east-1",
+			ExpectedSuffix: "sc2s.sgov.gov",
+		},
+		{
+			TestName:    "unknown region",
+			ServiceName: "ec2",
+			Region:      "unknown",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := servicePrincipalSuffix(testCase.ServiceName, testCase.Region)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedSuffix {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedSuffix)
+			}
+		})
+	}
+}
+
+func TestAccDataSourceAwsServicePrincipal_basic(t *testing.T) {
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsServicePrincipalConfig("s3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("s3.%s.amazonaws.com", testAccGetRegion())),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "s3.amazonaws.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", testAccGetRegion()),
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", "amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsServicePrincipal_Region(t *testing.T) {
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsServicePrincipalConfigRegion("logs", "cn-north-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "logs.cn-north-1.amazonaws.com.cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "logs.amazonaws.com.cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", "cn-north-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", "amazonaws.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsServicePrincipalConfig(serviceName string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+}
+`, serviceName)
+}
+
+func testAccDataSourceAwsServicePrincipalConfigRegion(serviceName, region string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+  region       = %[2]q
+}
+`, serviceName, region)
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importStatePassthroughARN returns an import StateFunc that accepts either the
+// resource ID or an ARN whose resource part is "<resourceType>/<ID>".
+//
+// When an ARN is given, only the trailing ID is kept as the resource ID so that
+// bulk import tooling working from ARNs does not need to parse them first.
+func importStatePassthroughARN(resourceType string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id, err := resourceIDFromARN(d.Id(), resourceType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// resourceIDFromARN returns the ID from an ARN whose resource part is
+// "<resourceType>/<ID>". Values that are not ARNs are returned unchanged.
+func resourceIDFromARN(v, resourceType string) (string, error) {
+	if !arn.IsARN(v) {
+		return v, nil
+	}
+
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	prefix := resourceType + "/"
+	id := strings.TrimPrefix(parsedARN.Resource, prefix)
+
+	if id == parsedARN.Resource || id == "" {
+		return "", fmt.Errorf("unexpected format for ARN (%s), expected resource %s<ID>", v, prefix)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceIDFromARN(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ResourceType  string
+		ExpectedID    string
+		ExpectedError bool
+	}{
+		{
+			TestName:     "plain ID",
+			Value:        "Z1D633PJN98FT9",
+			ResourceType: "hostedzone",
+			ExpectedID:   "Z1D633PJN98FT9",
+		},
+		{
+			TestName:     "global ARN",
+			Value:        "arn:aws:route53:::hostedzone/Z1D633PJN98FT9",
+			ResourceType: "hostedzone",
+			ExpectedID:   "Z1D633PJN98FT9",
+		},
+		{
+			TestName:     "regional ARN",
+			Value:        "arn:aws:athena:us-west-2:123456789012:workgroup/primary",
+			ResourceType: "workgroup",
+			ExpectedID:   "primary",
+		},
+		{
+			TestName:      "wrong resource type",
+			Value:         "arn:aws:route53:::healthcheck/abcdef11-2222-3333-4444-555555fedcba",
+			ResourceType:  "hostedzone",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing ID",
+			Value:         "arn:aws:route53:::hostedzone/",
+			ResourceType:  "hostedzone",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := resourceIDFromARN(testCase.Value, testCase.ResourceType)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
+// testAccImportStateIdFuncARN returns the resource's ARN as the import ID.
+func testAccImportStateIdFuncARN(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
@@ -23,6 +23,7 @@ var serviceNames = []string{
 	"amplify",
 	"apigatewayv2",
 	"appconfig",
+	"appintegrationsservice",
 	"appmesh",
 	"apprunner",
 	"appstream",
@@ -47,6 +48,7 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"customerprofiles",
 	"databasemigrationservice",
 	"dataexchange",
 	"datasync",
@@ -68,6 +70,7 @@ var serviceNames = []string{
 	"elb",
 	"elbv2",
 	"firehose",
+	"frauddetector",
 	"fsx",
 	"gamelift",
 	"glacier",
@@ -75,6 +78,7 @@ var serviceNames = []string{
 	"glue",
 	"guardduty",
 	"greengrass",
+	"healthlake",
 	"imagebuilder",
 	"inspector",
 	"iot",
@@ -87,6 +91,7 @@ var serviceNames = []string{
 	"kinesisvideo",
 	"kms",
 	"lambda",
+	"lexmodelsv2",
 	"licensemanager",
 	"mediaconnect",
 	"mediaconvert",
@@ -58,9 +58,11 @@ var sliceServiceNames = []string{
 	"emr",
 	"firehose",
 	"fms",
+	"frauddetector",
 	"fsx",
 	"gamelift",
 	"globalaccelerator",
+	"healthlake",
 	"iam",
 	"inspector",
 	"iot",
@@ -113,8 +115,10 @@ var mapServiceNames = []string{
 	"apigateway",
 	"apigatewayv2",
 	"appconfig",
+	"appintegrationsservice",
 	"appstream",
 	"appsync",
+	"auditmanager",
 	"backup",
 	"batch",
 	"cloudwatchlogs",
@@ -122,9 +126,11 @@ var mapServiceNames = []string{
 	"codestarnotifications",
 	"cognitoidentity",
 	"cognitoidentityprovider",
+	"customerprofiles",
 	"dataexchange",
 	"dlm",
 	"eks",
+	"emrcontainers",
 	"glacier",
 	"glue",
 	"guardduty",
@@ -133,6 +139,7 @@ var mapServiceNames = []string{
 	"kinesisvideo",
 	"imagebuilder",
 	"lambda",
+	"lexmodelsv2",
 	"macie2",
 	"mediaconnect",
 	"mediaconvert",
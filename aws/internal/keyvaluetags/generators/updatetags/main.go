@@ -24,11 +24,13 @@ var serviceNames = []string{
 	"apigateway",
 	"apigatewayv2",
 	"appconfig",
+	"appintegrationsservice",
 	"appmesh",
 	"apprunner",
 	"appstream",
 	"appsync",
 	"athena",
+	"auditmanager",
 	"autoscaling",
 	"backup",
 	"batch",
@@ -48,6 +50,7 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"customerprofiles",
 	"databasemigrationservice",
 	"dataexchange",
 	"datapipeline",
@@ -70,7 +73,9 @@ var serviceNames = []string{
 	"elb",
 	"elbv2",
 	"emr",
+	"emrcontainers",
 	"firehose",
+	"frauddetector",
 	"fsx",
 	"gamelift",
 	"glacier",
@@ -78,6 +83,7 @@ var serviceNames = []string{
 	"glue",
 	"guardduty",
 	"greengrass",
+	"healthlake",
 	"imagebuilder",
 	"iot",
 	"iotanalytics",
@@ -89,6 +95,7 @@ var serviceNames = []string{
 	"kinesisvideo",
 	"kms",
 	"lambda",
+	"lexmodelsv2",
 	"licensemanager",
 	"lightsail",
 	"mediaconnect",
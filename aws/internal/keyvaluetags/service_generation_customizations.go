@@ -13,11 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/batch"
@@ -37,6 +39,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
@@ -59,7 +62,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/glacier"
@@ -67,6 +72,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/greengrass"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/healthlake"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -79,6 +85,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/kinesisvideo"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
@@ -150,6 +157,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(apigatewayv2.New)
 	case "appconfig":
 		funcType = reflect.TypeOf(appconfig.New)
+	case "appintegrationsservice":
+		funcType = reflect.TypeOf(appintegrationsservice.New)
 	case "appmesh":
 		funcType = reflect.TypeOf(appmesh.New)
 	case "apprunner":
@@ -160,6 +169,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(appsync.New)
 	case "athena":
 		funcType = reflect.TypeOf(athena.New)
+	case "auditmanager":
+		funcType = reflect.TypeOf(auditmanager.New)
 	case "autoscaling":
 		funcType = reflect.TypeOf(autoscaling.New)
 	case "backup":
@@ -198,6 +209,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(cognitoidentityprovider.New)
 	case "configservice":
 		funcType = reflect.TypeOf(configservice.New)
+	case "customerprofiles":
+		funcType = reflect.TypeOf(customerprofiles.New)
 	case "databasemigrationservice":
 		funcType = reflect.TypeOf(databasemigrationservice.New)
 	case "dataexchange":
@@ -242,8 +255,12 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(elbv2.New)
 	case "emr":
 		funcType = reflect.TypeOf(emr.New)
+	case "emrcontainers":
+		funcType = reflect.TypeOf(emrcontainers.New)
 	case "firehose":
 		funcType = reflect.TypeOf(firehose.New)
+	case "frauddetector":
+		funcType = reflect.TypeOf(frauddetector.New)
 	case "fsx":
 		funcType = reflect.TypeOf(fsx.New)
 	case "gamelift":
@@ -258,6 +275,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(guardduty.New)
 	case "greengrass":
 		funcType = reflect.TypeOf(greengrass.New)
+	case "healthlake":
+		funcType = reflect.TypeOf(healthlake.New)
 	case "imagebuilder":
 		funcType = reflect.TypeOf(imagebuilder.New)
 	case "inspector":
@@ -282,6 +301,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(kms.New)
 	case "lambda":
 		funcType = reflect.TypeOf(lambda.New)
+	case "lexmodelsv2":
+		funcType = reflect.TypeOf(lexmodelsv2.New)
 	case "licensemanager":
 		funcType = reflect.TypeOf(licensemanager.New)
 	case "lightsail":
@@ -742,12 +763,16 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "ResourceId"
 	case "firehose":
 		return "DeliveryStreamName"
+	case "frauddetector":
+		return "ResourceARN"
 	case "fsx":
 		return "ResourceARN"
 	case "gamelift":
 		return "ResourceARN"
 	case "glacier":
 		return "VaultName"
+	case "healthlake":
+		return "ResourceARN"
 	case "kinesis":
 		return "StreamName"
 	case "kinesisanalytics":
@@ -760,6 +785,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "KeyId"
 	case "lambda":
 		return "Resource"
+	case "lexmodelsv2":
+		return "ResourceARN"
 	case "lightsail":
 		return "ResourceName"
 	case "mediaconvert":
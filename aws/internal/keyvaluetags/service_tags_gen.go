@@ -46,9 +46,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/healthlake"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -147,6 +149,16 @@ func AppconfigKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// AppintegrationsserviceTags returns appintegrationsservice service tags.
+func (tags KeyValueTags) AppintegrationsserviceTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// AppintegrationsserviceKeyValueTags creates KeyValueTags from appintegrationsservice service tags.
+func AppintegrationsserviceKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // AppstreamTags returns appstream service tags.
 func (tags KeyValueTags) AppstreamTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -167,6 +179,16 @@ func AppsyncKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// AuditmanagerTags returns auditmanager service tags.
+func (tags KeyValueTags) AuditmanagerTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// AuditmanagerKeyValueTags creates KeyValueTags from auditmanager service tags.
+func AuditmanagerKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // BackupTags returns backup service tags.
 func (tags KeyValueTags) BackupTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -237,6 +259,16 @@ func CognitoidentityproviderKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// CustomerprofilesTags returns customerprofiles service tags.
+func (tags KeyValueTags) CustomerprofilesTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// CustomerprofilesKeyValueTags creates KeyValueTags from customerprofiles service tags.
+func CustomerprofilesKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // DataexchangeTags returns dataexchange service tags.
 func (tags KeyValueTags) DataexchangeTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -267,6 +299,16 @@ func EksKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// EmrcontainersTags returns emrcontainers service tags.
+func (tags KeyValueTags) EmrcontainersTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// EmrcontainersKeyValueTags creates KeyValueTags from emrcontainers service tags.
+func EmrcontainersKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // GlacierTags returns glacier service tags.
 func (tags KeyValueTags) GlacierTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -347,6 +389,16 @@ func LambdaKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// Lexmodelsv2Tags returns lexmodelsv2 service tags.
+func (tags KeyValueTags) Lexmodelsv2Tags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// Lexmodelsv2KeyValueTags creates KeyValueTags from lexmodelsv2 service tags.
+func Lexmodelsv2KeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // Macie2Tags returns macie2 service tags.
 func (tags KeyValueTags) Macie2Tags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -1769,6 +1821,33 @@ func FmsKeyValueTags(tags []*fms.ResourceTag) KeyValueTags {
 	return New(m)
 }
 
+// FrauddetectorTags returns frauddetector service tags.
+func (tags KeyValueTags) FrauddetectorTags() []*frauddetector.Tag {
+	result := make([]*frauddetector.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &frauddetector.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// FrauddetectorKeyValueTags creates KeyValueTags from frauddetector service tags.
+func FrauddetectorKeyValueTags(tags []*frauddetector.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // FsxTags returns fsx service tags.
 func (tags KeyValueTags) FsxTags() []*fsx.Tag {
 	result := make([]*fsx.Tag, 0, len(tags))
@@ -1850,6 +1929,33 @@ func GlobalacceleratorKeyValueTags(tags []*globalaccelerator.Tag) KeyValueTags {
 	return New(m)
 }
 
+// HealthlakeTags returns healthlake service tags.
+func (tags KeyValueTags) HealthlakeTags() []*healthlake.Tag {
+	result := make([]*healthlake.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &healthlake.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// HealthlakeKeyValueTags creates KeyValueTags from healthlake service tags.
+func HealthlakeKeyValueTags(tags []*healthlake.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // IamTags returns iam service tags.
 func (tags KeyValueTags) IamTags() []*iam.Tag {
 	result := make([]*iam.Tag, 0, len(tags))
@@ -0,0 +1,25 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"strings"
+)
+
+const archiveRuleIDSeparator = "/"
+
+func ArchiveRuleCreateID(analyzerName, ruleName string) string {
+	parts := []string{analyzerName, ruleName}
+	id := strings.Join(parts, archiveRuleIDSeparator)
+
+	return id
+}
+
+func ArchiveRuleParseID(id string) (string, string, error) {
+	parts := strings.Split(id, archiveRuleIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected analyzer-name%srule-name", id, archiveRuleIDSeparator)
+}
@@ -0,0 +1,25 @@
+package auditmanager
+
+import (
+	"fmt"
+	"strings"
+)
+
+const assessmentReportIDSeparator = "/"
+
+func AssessmentReportCreateID(assessmentID, reportID string) string {
+	parts := []string{assessmentID, reportID}
+	id := strings.Join(parts, assessmentReportIDSeparator)
+
+	return id
+}
+
+func AssessmentReportParseID(id string) (string, string, error) {
+	parts := strings.Split(id, assessmentReportIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected assessment-id%sassessment-report-id", id, assessmentReportIDSeparator)
+}
@@ -0,0 +1,29 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	AssessmentReportCreatedTimeout = 10 * time.Minute
+)
+
+func AssessmentReportCreated(conn *auditmanager.AuditManager, assessmentID, reportID string) (*auditmanager.AssessmentReportMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{auditmanager.AssessmentReportStatusInProgress},
+		Target:  []string{auditmanager.AssessmentReportStatusComplete},
+		Refresh: AssessmentReportStatus(conn, assessmentID, reportID),
+		Timeout: AssessmentReportCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*auditmanager.AssessmentReportMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
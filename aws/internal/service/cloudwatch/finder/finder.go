@@ -2,11 +2,59 @@ package finder
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+// AnomalyDetector returns the anomaly detector for the specified metric and statistic.
+// Anomaly detectors have no identifier of their own and are matched on their full definition.
+func AnomalyDetector(conn *cloudwatch.CloudWatch, namespace, metricName, stat string, dimensions []*cloudwatch.Dimension) (*cloudwatch.AnomalyDetector, error) {
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{
+		Dimensions: dimensions,
+		MetricName: aws.String(metricName),
+		Namespace:  aws.String(namespace),
+	}
+
+	for {
+		output, err := conn.DescribeAnomalyDetectors(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.AnomalyDetectors {
+			if v == nil {
+				continue
+			}
+
+			// The Dimensions filter also matches detectors with additional dimensions.
+			if aws.StringValue(v.Namespace) == namespace &&
+				aws.StringValue(v.MetricName) == metricName &&
+				aws.StringValue(v.Stat) == stat &&
+				reflect.DeepEqual(dimensionsMap(v.Dimensions), dimensionsMap(dimensions)) {
+				return v, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
 func CompositeAlarmByName(ctx context.Context, conn *cloudwatch.CloudWatch, name string) (*cloudwatch.CompositeAlarm, error) {
 	input := cloudwatch.DescribeAlarmsInput{
 		AlarmNames: aws.StringSlice([]string{name}),
@@ -42,3 +90,13 @@ func MetricAlarmByName(conn *cloudwatch.CloudWatch, name string) (*cloudwatch.Me
 
 	return output.MetricAlarms[0], nil
 }
+
+func dimensionsMap(dimensions []*cloudwatch.Dimension) map[string]string {
+	m := make(map[string]string, len(dimensions))
+
+	for _, v := range dimensions {
+		m[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+	}
+
+	return m
+}
@@ -0,0 +1,65 @@
+package cloudwatch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	anomalyDetectorResourceIDSeparator          = ":"
+	anomalyDetectorResourceIDDimensionSeparator = ","
+)
+
+// AnomalyDetectorCreateResourceID returns an ID built from the anomaly detector's definition.
+// Dimensions are sorted by name so that the ID does not depend on map ordering.
+func AnomalyDetectorCreateResourceID(namespace, metricName, stat string, dimensions map[string]string) string {
+	parts := []string{namespace, metricName, stat}
+
+	if len(dimensions) > 0 {
+		names := make([]string, 0, len(dimensions))
+		for name := range dimensions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		pairs := make([]string, 0, len(names))
+		for _, name := range names {
+			pairs = append(pairs, name+"="+dimensions[name])
+		}
+
+		parts = append(parts, strings.Join(pairs, anomalyDetectorResourceIDDimensionSeparator))
+	}
+
+	id := strings.Join(parts, anomalyDetectorResourceIDSeparator)
+
+	return id
+}
+
+// AnomalyDetectorParseResourceID parses an ID created by AnomalyDetectorCreateResourceID.
+// Namespaces cannot contain colons. The metric name and statistic must not contain colons either.
+func AnomalyDetectorParseResourceID(id string) (string, string, string, map[string]string, error) {
+	parts := strings.SplitN(id, anomalyDetectorResourceIDSeparator, 4)
+
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", nil, fmt.Errorf("unexpected format for ID (%[1]s), expected NAMESPACE%[2]sMETRICNAME%[2]sSTAT or NAMESPACE%[2]sMETRICNAME%[2]sSTAT%[2]sNAME=VALUE[%[3]sNAME=VALUE...]", id, anomalyDetectorResourceIDSeparator, anomalyDetectorResourceIDDimensionSeparator)
+	}
+
+	if len(parts) == 3 {
+		return parts[0], parts[1], parts[2], nil, nil
+	}
+
+	dimensions := make(map[string]string)
+
+	for _, pair := range strings.Split(parts[3], anomalyDetectorResourceIDDimensionSeparator) {
+		kv := strings.SplitN(pair, "=", 2)
+
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return "", "", "", nil, fmt.Errorf("unexpected format for dimension (%s) in ID (%s), expected NAME=VALUE", pair, id)
+		}
+
+		dimensions[kv[0]] = kv[1]
+	}
+
+	return parts[0], parts[1], parts[2], dimensions, nil
+}
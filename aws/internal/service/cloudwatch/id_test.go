@@ -0,0 +1,92 @@
+package cloudwatch_test
+
+import (
+	"reflect"
+	"testing"
+
+	tfcloudwatch "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch"
+)
+
+func TestAnomalyDetectorParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		InputID            string
+		ExpectedError      bool
+		ExpectedNamespace  string
+		ExpectedMetricName string
+		ExpectedStat       string
+		ExpectedDimensions map[string]string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "two parts",
+			InputID:       "AWS/Lambda:Invocations",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty stat",
+			InputID:       "AWS/Lambda:Invocations:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid dimension",
+			InputID:       "AWS/Lambda:Invocations:Sum:FunctionName",
+			ExpectedError: true,
+		},
+		{
+			TestName:           "no dimensions",
+			InputID:            tfcloudwatch.AnomalyDetectorCreateResourceID("AWS/Lambda", "Invocations", "Sum", nil),
+			ExpectedNamespace:  "AWS/Lambda",
+			ExpectedMetricName: "Invocations",
+			ExpectedStat:       "Sum",
+		},
+		{
+			TestName: "dimensions",
+			InputID: tfcloudwatch.AnomalyDetectorCreateResourceID("AWS/Lambda", "Invocations", "Sum", map[string]string{
+				"Resource":     "test:live",
+				"FunctionName": "test",
+			}),
+			ExpectedNamespace:  "AWS/Lambda",
+			ExpectedMetricName: "Invocations",
+			ExpectedStat:       "Sum",
+			ExpectedDimensions: map[string]string{
+				"FunctionName": "test",
+				"Resource":     "test:live",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotNamespace, gotMetricName, gotStat, gotDimensions, err := tfcloudwatch.AnomalyDetectorParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotNamespace != testCase.ExpectedNamespace {
+				t.Errorf("got namespace %s, expected %s", gotNamespace, testCase.ExpectedNamespace)
+			}
+
+			if gotMetricName != testCase.ExpectedMetricName {
+				t.Errorf("got metric name %s, expected %s", gotMetricName, testCase.ExpectedMetricName)
+			}
+
+			if gotStat != testCase.ExpectedStat {
+				t.Errorf("got stat %s, expected %s", gotStat, testCase.ExpectedStat)
+			}
+
+			if !reflect.DeepEqual(gotDimensions, testCase.ExpectedDimensions) {
+				t.Errorf("got dimensions %v, expected %v", gotDimensions, testCase.ExpectedDimensions)
+			}
+		})
+	}
+}
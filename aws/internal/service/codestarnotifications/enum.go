@@ -0,0 +1,13 @@
+package codestarnotifications
+
+const (
+	TargetTypeSNS             = "SNS"
+	TargetTypeAWSChatbotSlack = "AWSChatbotSlack"
+)
+
+func TargetType_Values() []string {
+	return []string{
+		TargetTypeSNS,
+		TargetTypeAWSChatbotSlack,
+	}
+}
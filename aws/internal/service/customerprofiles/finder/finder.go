@@ -0,0 +1,86 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func DomainByName(conn *customerprofiles.CustomerProfiles, name string) (*customerprofiles.GetDomainOutput, error) {
+	input := &customerprofiles.GetDomainInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.GetDomain(input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func IntegrationByDomainNameAndURI(conn *customerprofiles.CustomerProfiles, domainName, uri string) (*customerprofiles.GetIntegrationOutput, error) {
+	input := &customerprofiles.GetIntegrationInput{
+		DomainName: aws.String(domainName),
+		Uri:        aws.String(uri),
+	}
+
+	output, err := conn.GetIntegration(input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func ProfileObjectTypeByDomainNameAndName(conn *customerprofiles.CustomerProfiles, domainName, objectTypeName string) (*customerprofiles.GetProfileObjectTypeOutput, error) {
+	input := &customerprofiles.GetProfileObjectTypeInput{
+		DomainName:     aws.String(domainName),
+		ObjectTypeName: aws.String(objectTypeName),
+	}
+
+	output, err := conn.GetProfileObjectType(input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,43 @@
+package customerprofiles
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = "/"
+
+func IntegrationCreateResourceID(domainName, uri string) string {
+	parts := []string{domainName, uri}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+// IntegrationParseResourceID splits on the first separator only, as integration URIs are ARNs that may contain it.
+func IntegrationParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, resourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]suri", id, resourceIDSeparator)
+}
+
+func ProfileObjectTypeCreateResourceID(domainName, objectTypeName string) string {
+	parts := []string{domainName, objectTypeName}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func ProfileObjectTypeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]sobject-type-name", id, resourceIDSeparator)
+}
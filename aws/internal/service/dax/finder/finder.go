@@ -0,0 +1,34 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func ClusterByName(conn *dax.DAX, name string) (*dax.Cluster, error) {
+	input := &dax.DescribeClustersInput{
+		ClusterNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, dax.ErrCodeClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Clusters[0], nil
+}
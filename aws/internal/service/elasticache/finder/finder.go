@@ -226,3 +226,29 @@ func ElastiCacheUserGroupById(conn *elasticache.ElastiCache, groupID string) (*e
 		}
 	}
 }
+
+// ReservedCacheNodeByID retrieves an ElastiCache Reserved Cache Node by id.
+func ReservedCacheNodeByID(conn *elasticache.ElastiCache, id string) (*elasticache.ReservedCacheNode, error) {
+	input := &elasticache.DescribeReservedCacheNodesInput{
+		ReservedCacheNodeId: aws.String(id),
+	}
+	output, err := conn.DescribeReservedCacheNodes(input)
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeReservedCacheNodeNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ReservedCacheNodes) == 0 || output.ReservedCacheNodes[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ReservedCacheNodes[0], nil
+}
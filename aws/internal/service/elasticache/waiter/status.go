@@ -16,6 +16,11 @@ const (
 	ReplicationGroupStatusCreateFailed = "create-failed"
 	ReplicationGroupStatusSnapshotting = "snapshotting"
 
+	ReservedCacheNodeStatusActive         = "active"
+	ReservedCacheNodeStatusPaymentFailed  = "payment-failed"
+	ReservedCacheNodeStatusPaymentPending = "payment-pending"
+	ReservedCacheNodeStatusRetired        = "retired"
+
 	UserStatusActive    = "active"
 	UserStatusDeleting  = "deleting"
 	UserStatusModifying = "modifying"
@@ -112,7 +117,10 @@ func GlobalReplicationGroupStatus(conn *elasticache.ElastiCache, globalReplicati
 }
 
 const (
-	GlobalReplicationGroupMemberStatusAssociated = "associated"
+	GlobalReplicationGroupMemberStatusAssociating = "associating"
+	GlobalReplicationGroupMemberStatusAssociated  = "associated"
+
+	globalReplicationGroupMemberRolePrimary = "PRIMARY"
 )
 
 // GlobalReplicationGroupStatus fetches the Global Replication Group and its Status
@@ -130,6 +138,27 @@ func GlobalReplicationGroupMemberStatus(conn *elasticache.ElastiCache, globalRep
 	}
 }
 
+// GlobalReplicationGroupPrimaryMember fetches the Global Replication Group and returns the ID of its primary member as the state
+func GlobalReplicationGroupPrimaryMember(conn *elasticache.ElastiCache, globalReplicationGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		grg, err := finder.GlobalReplicationGroupByID(conn, globalReplicationGroupID)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, member := range grg.Members {
+			if aws.StringValue(member.Role) == globalReplicationGroupMemberRolePrimary {
+				return grg, aws.StringValue(member.ReplicationGroupId), nil
+			}
+		}
+
+		return grg, "", nil
+	}
+}
+
 // UserStatus fetches the ElastiCache user and its Status
 func UserStatus(conn *elasticache.ElastiCache, userId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -146,3 +175,18 @@ func UserStatus(conn *elasticache.ElastiCache, userId string) resource.StateRefr
 		return user, aws.StringValue(user.Status), nil
 	}
 }
+
+// ReservedCacheNodeStatus fetches the Reserved Cache Node and its State
+func ReservedCacheNodeStatus(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		node, err := finder.ReservedCacheNodeByID(conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return node, aws.StringValue(node.State), nil
+	}
+}
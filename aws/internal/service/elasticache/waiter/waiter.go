@@ -234,6 +234,74 @@ func GlobalReplicationGroupMemberDetached(conn *elasticache.ElastiCache, globalR
 	return nil, err
 }
 
+const (
+	globalReplicationGroupAssociationTimeout    = 20 * time.Minute
+	globalReplicationGroupAssociationMinTimeout = 10 * time.Second
+	globalReplicationGroupAssociationDelay      = 30 * time.Second
+)
+
+// GlobalReplicationGroupMemberAssociated waits for a Replication Group to be associated with a Global Replication Group
+func GlobalReplicationGroupMemberAssociated(conn *elasticache.ElastiCache, globalReplicationGroupID, id string) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"",
+			GlobalReplicationGroupMemberStatusAssociating,
+		},
+		Target:     []string{GlobalReplicationGroupMemberStatusAssociated},
+		Refresh:    GlobalReplicationGroupMemberStatus(conn, globalReplicationGroupID, id),
+		Timeout:    globalReplicationGroupAssociationTimeout,
+		MinTimeout: globalReplicationGroupAssociationMinTimeout,
+		Delay:      globalReplicationGroupAssociationDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// GlobalReplicationGroupFailedOver waits for the primary member of a Global Replication Group to change
+func GlobalReplicationGroupFailedOver(conn *elasticache.ElastiCache, globalReplicationGroupID, oldPrimaryID, newPrimaryID string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"", oldPrimaryID},
+		Target:     []string{newPrimaryID},
+		Refresh:    GlobalReplicationGroupPrimaryMember(conn, globalReplicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupAvailableMinTimeout,
+		Delay:      globalReplicationGroupAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+const (
+	reservedCacheNodeActiveMinTimeout = 10 * time.Second
+	reservedCacheNodeActiveDelay      = 30 * time.Second
+)
+
+// ReservedCacheNodeActive waits for a purchased Reserved Cache Node to become active
+func ReservedCacheNodeActive(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.ReservedCacheNode, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ReservedCacheNodeStatusPaymentPending},
+		Target:     []string{ReservedCacheNodeStatusActive},
+		Refresh:    ReservedCacheNodeStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: reservedCacheNodeActiveMinTimeout,
+		Delay:      reservedCacheNodeActiveDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*elasticache.ReservedCacheNode); ok {
+		return v, err
+	}
+	return nil, err
+}
+
 // UserActive waits for an ElastiCache user to reach an active state after modifications
 func UserActive(conn *elasticache.ElastiCache, userId string) error {
 	stateConf := &resource.StateChangeConf{
@@ -0,0 +1,15 @@
+package frauddetector
+
+// Model version statuses returned by DescribeModelVersions.
+const (
+	ModelVersionStatusActivateInProgress   = "ACTIVATE_IN_PROGRESS"
+	ModelVersionStatusActivateRequested    = "ACTIVATE_REQUESTED"
+	ModelVersionStatusActive               = "ACTIVE"
+	ModelVersionStatusError                = "ERROR"
+	ModelVersionStatusInactivateInProgress = "INACTIVATE_IN_PROGRESS"
+	ModelVersionStatusInactivateRequested  = "INACTIVATE_REQUESTED"
+	ModelVersionStatusInactive             = "INACTIVE"
+	ModelVersionStatusTrainingCancelled    = "TRAINING_CANCELLED"
+	ModelVersionStatusTrainingComplete     = "TRAINING_COMPLETE"
+	ModelVersionStatusTrainingInProgress   = "TRAINING_IN_PROGRESS"
+)
@@ -0,0 +1,286 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func DetectorByID(conn *frauddetector.FraudDetector, id string) (*frauddetector.Detector, error) {
+	input := &frauddetector.GetDetectorsInput{
+		DetectorId: aws.String(id),
+	}
+
+	output, err := conn.GetDetectors(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Detectors) == 0 || output.Detectors[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Detectors); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Detectors[0], nil
+}
+
+func EntityTypeByName(conn *frauddetector.FraudDetector, name string) (*frauddetector.EntityType, error) {
+	input := &frauddetector.GetEntityTypesInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEntityTypes(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.EntityTypes) == 0 || output.EntityTypes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.EntityTypes); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.EntityTypes[0], nil
+}
+
+func EventTypeByName(conn *frauddetector.FraudDetector, name string) (*frauddetector.EventType, error) {
+	input := &frauddetector.GetEventTypesInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEventTypes(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.EventTypes) == 0 || output.EventTypes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.EventTypes); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.EventTypes[0], nil
+}
+
+func ModelByIDAndType(conn *frauddetector.FraudDetector, id, modelType string) (*frauddetector.Model, error) {
+	input := &frauddetector.GetModelsInput{
+		ModelId:   aws.String(id),
+		ModelType: aws.String(modelType),
+	}
+
+	output, err := conn.GetModels(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Models) == 0 || output.Models[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Models); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Models[0], nil
+}
+
+func ModelVersionByIDTypeAndVersionNumber(conn *frauddetector.FraudDetector, id, modelType, modelVersionNumber string) (*frauddetector.ModelVersionDetail, error) {
+	input := &frauddetector.DescribeModelVersionsInput{
+		ModelId:            aws.String(id),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+	}
+
+	output, err := conn.DescribeModelVersions(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ModelVersionDetails) == 0 || output.ModelVersionDetails[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ModelVersionDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ModelVersionDetails[0], nil
+}
+
+func LabelByName(conn *frauddetector.FraudDetector, name string) (*frauddetector.Label, error) {
+	input := &frauddetector.GetLabelsInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetLabels(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Labels) == 0 || output.Labels[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Labels); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Labels[0], nil
+}
+
+func OutcomeByName(conn *frauddetector.FraudDetector, name string) (*frauddetector.Outcome, error) {
+	input := &frauddetector.GetOutcomesInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetOutcomes(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Outcomes) == 0 || output.Outcomes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Outcomes); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Outcomes[0], nil
+}
+
+// RuleVersionsByDetectorIDAndRuleID returns all versions of the specified rule.
+func RuleVersionsByDetectorIDAndRuleID(conn *frauddetector.FraudDetector, detectorID, ruleID string) ([]*frauddetector.RuleDetail, error) {
+	input := &frauddetector.GetRulesInput{
+		DetectorId: aws.String(detectorID),
+		RuleId:     aws.String(ruleID),
+	}
+	var output []*frauddetector.RuleDetail
+
+	for {
+		page, err := conn.GetRules(input)
+
+		if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RuleDetails {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func VariableByName(conn *frauddetector.FraudDetector, name string) (*frauddetector.Variable, error) {
+	input := &frauddetector.GetVariablesInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetVariables(input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Variables) == 0 || output.Variables[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Variables); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Variables[0], nil
+}
@@ -0,0 +1,59 @@
+package frauddetector
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = "/"
+
+func ModelCreateResourceID(modelID, modelType string) string {
+	parts := []string{modelID, modelType}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func ModelParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MODEL_ID%[2]sMODEL_TYPE", id, resourceIDSeparator)
+}
+
+func ModelVersionCreateResourceID(modelID, modelType, modelVersionNumber string) string {
+	parts := []string{modelID, modelType, modelVersionNumber}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func ModelVersionParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MODEL_ID%[2]sMODEL_TYPE%[2]sMODEL_VERSION_NUMBER", id, resourceIDSeparator)
+}
+
+func RuleCreateResourceID(detectorID, ruleID string) string {
+	parts := []string{detectorID, ruleID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func RuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTOR_ID%[2]sRULE_ID", id, resourceIDSeparator)
+}
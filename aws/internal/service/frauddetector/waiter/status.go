@@ -0,0 +1,25 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func ModelVersionStatus(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ModelVersionByIDTypeAndVersionNumber(conn, modelID, modelType, modelVersionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
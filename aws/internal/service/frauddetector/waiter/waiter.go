@@ -0,0 +1,68 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tffrauddetector "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector"
+)
+
+func ModelVersionTrained(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.ModelVersionDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tffrauddetector.ModelVersionStatusTrainingInProgress},
+		Target:  []string{tffrauddetector.ModelVersionStatusTrainingComplete},
+		Refresh: ModelVersionStatus(conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*frauddetector.ModelVersionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func ModelVersionActivated(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.ModelVersionDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			tffrauddetector.ModelVersionStatusActivateInProgress,
+			tffrauddetector.ModelVersionStatusActivateRequested,
+			tffrauddetector.ModelVersionStatusTrainingComplete,
+		},
+		Target:  []string{tffrauddetector.ModelVersionStatusActive},
+		Refresh: ModelVersionStatus(conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*frauddetector.ModelVersionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func ModelVersionInactivated(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.ModelVersionDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			tffrauddetector.ModelVersionStatusActive,
+			tffrauddetector.ModelVersionStatusInactivateInProgress,
+			tffrauddetector.ModelVersionStatusInactivateRequested,
+		},
+		Target:  []string{tffrauddetector.ModelVersionStatusInactive},
+		Refresh: ModelVersionStatus(conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*frauddetector.ModelVersionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
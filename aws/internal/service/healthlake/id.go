@@ -0,0 +1,25 @@
+package healthlake
+
+import (
+	"fmt"
+	"strings"
+)
+
+const fhirImportJobResourceIDSeparator = "/"
+
+func FHIRImportJobCreateResourceID(datastoreID, jobID string) string {
+	parts := []string{datastoreID, jobID}
+	id := strings.Join(parts, fhirImportJobResourceIDSeparator)
+
+	return id
+}
+
+func FHIRImportJobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, fhirImportJobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected datastore-id%[2]sjob-id", id, fhirImportJobResourceIDSeparator)
+}
@@ -0,0 +1,41 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func FHIRDatastoreStatus(conn *healthlake.HealthLake, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.FHIRDatastoreByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DatastoreStatus), nil
+	}
+}
+
+func FHIRImportJobStatus(conn *healthlake.HealthLake, datastoreID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.FHIRImportJobByDatastoreIDAndJobID(conn, datastoreID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobStatus), nil
+	}
+}
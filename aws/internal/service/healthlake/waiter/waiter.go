@@ -0,0 +1,66 @@
+package waiter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func FHIRDatastoreCreated(conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusCreating},
+		Target:  []string{healthlake.DatastoreStatusActive},
+		Refresh: FHIRDatastoreStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func FHIRDatastoreDeleted(conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusActive, healthlake.DatastoreStatusDeleting},
+		Target:  []string{},
+		Refresh: FHIRDatastoreStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func FHIRImportJobCompleted(conn *healthlake.HealthLake, datastoreID, jobID string, timeout time.Duration) (*healthlake.ImportJobProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.JobStatusSubmitted, healthlake.JobStatusInProgress},
+		Target:  []string{healthlake.JobStatusCompleted},
+		Refresh: FHIRImportJobStatus(conn, datastoreID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*healthlake.ImportJobProperties); ok {
+		if status := aws.StringValue(output.JobStatus); status == healthlake.JobStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -22,9 +22,11 @@ const (
 	KeyDeletedTimeout                = 20 * time.Minute
 	KeyDescriptionPropagationTimeout = 5 * time.Minute
 	KeyMaterialImportedTimeout       = 10 * time.Minute
+	KeyPrimaryRegionUpdatedTimeout   = 10 * time.Minute
 	KeyRotationUpdatedTimeout        = 10 * time.Minute
 	KeyStatePropagationTimeout       = 20 * time.Minute
 	KeyValidToPropagationTimeout     = 5 * time.Minute
+	ReplicaKeyCreatedTimeout         = 5 * time.Minute
 
 	PropagationTimeout = 2 * time.Minute
 )
@@ -119,6 +121,37 @@ func KeyPolicyPropagated(conn *kms.KMS, id, policy string) error {
 	return tfresource.WaitUntil(PropagationTimeout, checkFunc, opts)
 }
 
+// KeyPrimaryRegionUpdated waits until the multi-Region key's primary key is in the specified region.
+func KeyPrimaryRegionUpdated(conn *kms.KMS, id, region string) error {
+	checkFunc := func() (bool, error) {
+		output, err := finder.KeyByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if aws.StringValue(output.KeyState) == kms.KeyStateUpdating {
+			return false, nil
+		}
+
+		if v := output.MultiRegionConfiguration; v != nil && v.PrimaryKey != nil {
+			return aws.StringValue(v.PrimaryKey.Region) == region, nil
+		}
+
+		return false, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 5,
+		MinTimeout:                2 * time.Second,
+	}
+
+	return tfresource.WaitUntil(KeyPrimaryRegionUpdatedTimeout, checkFunc, opts)
+}
+
 func KeyRotationEnabledPropagated(conn *kms.KMS, id string, enabled bool) error {
 	checkFunc := func() (bool, error) {
 		output, err := finder.KeyRotationEnabledByKeyID(conn, id)
@@ -189,6 +222,23 @@ func KeyValidToPropagated(conn *kms.KMS, id string, validTo string) error {
 	return tfresource.WaitUntil(KeyValidToPropagationTimeout, checkFunc, opts)
 }
 
+func ReplicaKeyCreated(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.KeyStateCreating},
+		Target:  []string{kms.KeyStateEnabled},
+		Refresh: KeyState(conn, id),
+		Timeout: ReplicaKeyCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func TagsPropagated(conn *kms.KMS, id string, tags keyvaluetags.KeyValueTags) error {
 	checkFunc := func() (bool, error) {
 		output, err := keyvaluetags.KmsListTags(conn, id)
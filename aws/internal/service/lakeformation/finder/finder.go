@@ -0,0 +1,37 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+)
+
+// Permissions returns the permissions matching the input that are held by the input's principal.
+func Permissions(conn *lakeformation.LakeFormation, input *lakeformation.ListPermissionsInput) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	var permissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil || permission.Principal == nil {
+				continue
+			}
+
+			if aws.StringValue(input.Principal.DataLakePrincipalIdentifier) != aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) {
+				continue
+			}
+
+			permissions = append(permissions, permission)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,7 @@
+package lexmodelsv2
+
+const (
+	// BotVersionDraft is the working version of a bot. Locales, intents, slot types
+	// and slots can only be created and modified in the draft version.
+	BotVersionDraft = "DRAFT"
+)
@@ -0,0 +1,198 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func BotByID(conn *lexmodelsv2.LexModelsV2, id string) (*lexmodelsv2.DescribeBotOutput, error) {
+	input := &lexmodelsv2.DescribeBotInput{
+		BotId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBot(input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func BotAliasByID(conn *lexmodelsv2.LexModelsV2, botAliasID, botID string) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	input := &lexmodelsv2.DescribeBotAliasInput{
+		BotAliasId: aws.String(botAliasID),
+		BotId:      aws.String(botID),
+	}
+
+	output, err := conn.DescribeBotAlias(input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func BotLocaleByID(conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	input := &lexmodelsv2.DescribeBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+
+	output, err := conn.DescribeBotLocale(input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func BotVersionByID(conn *lexmodelsv2.LexModelsV2, botID, botVersion string) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	input := &lexmodelsv2.DescribeBotVersionInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+	}
+
+	output, err := conn.DescribeBotVersion(input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func IntentByID(conn *lexmodelsv2.LexModelsV2, intentID, botID, botVersion, localeID string) (*lexmodelsv2.DescribeIntentOutput, error) {
+	input := &lexmodelsv2.DescribeIntentInput{
+		IntentId:   aws.String(intentID),
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+
+	output, err := conn.DescribeIntent(input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func SlotByID(conn *lexmodelsv2.LexModelsV2, slotID, botID, botVersion, localeID, intentID string) (*lexmodelsv2.DescribeSlotOutput, error) {
+	input := &lexmodelsv2.DescribeSlotInput{
+		SlotId:     aws.String(slotID),
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+		IntentId:   aws.String(intentID),
+	}
+
+	output, err := conn.DescribeSlot(input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func SlotTypeByID(conn *lexmodelsv2.LexModelsV2, slotTypeID, botID, botVersion, localeID string) (*lexmodelsv2.DescribeSlotTypeOutput, error) {
+	input := &lexmodelsv2.DescribeSlotTypeInput{
+		SlotTypeId: aws.String(slotTypeID),
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+
+	output, err := conn.DescribeSlotType(input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,110 @@
+package lexmodelsv2
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = "/"
+
+func BotAliasCreateResourceID(botID, botAliasID string) string {
+	parts := []string{botID, botAliasID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func BotAliasParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT_ID%[2]sBOT_ALIAS_ID", id, resourceIDSeparator)
+}
+
+func BotLocaleCreateResourceID(botID, localeID string) string {
+	parts := []string{botID, localeID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func BotLocaleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT_ID%[2]sLOCALE_ID", id, resourceIDSeparator)
+}
+
+func BotVersionCreateResourceID(botID, botVersion string) string {
+	parts := []string{botID, botVersion}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func BotVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT_ID%[2]sBOT_VERSION", id, resourceIDSeparator)
+}
+
+func IntentCreateResourceID(botID, localeID, intentID string) string {
+	parts := []string{botID, localeID, intentID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func IntentParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT_ID%[2]sLOCALE_ID%[2]sINTENT_ID", id, resourceIDSeparator)
+}
+
+func SlotCreateResourceID(botID, localeID, intentID, slotID string) string {
+	parts := []string{botID, localeID, intentID, slotID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func SlotParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT_ID%[2]sLOCALE_ID%[2]sINTENT_ID%[2]sSLOT_ID", id, resourceIDSeparator)
+}
+
+func SlotTypeCreateResourceID(botID, localeID, slotTypeID string) string {
+	parts := []string{botID, localeID, slotTypeID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func SlotTypeParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT_ID%[2]sLOCALE_ID%[2]sSLOT_TYPE_ID", id, resourceIDSeparator)
+}
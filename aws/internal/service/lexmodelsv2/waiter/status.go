@@ -0,0 +1,73 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func BotStatus(conn *lexmodelsv2.LexModelsV2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.BotByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotStatus), nil
+	}
+}
+
+func BotAliasStatus(conn *lexmodelsv2.LexModelsV2, botAliasID, botID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.BotAliasByID(conn, botAliasID, botID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotAliasStatus), nil
+	}
+}
+
+func BotLocaleStatus(conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.BotLocaleByID(conn, botID, botVersion, localeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotLocaleStatus), nil
+	}
+}
+
+func BotVersionStatus(conn *lexmodelsv2.LexModelsV2, botID, botVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.BotVersionByID(conn, botID, botVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotStatus), nil
+	}
+}
@@ -0,0 +1,178 @@
+package waiter
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func BotAvailable(conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusCreating, lexmodelsv2.BotStatusVersioning},
+		Target:  []string{lexmodelsv2.BotStatusAvailable},
+		Refresh: BotStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotDeleted(conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusAvailable, lexmodelsv2.BotStatusDeleting, lexmodelsv2.BotStatusInactive},
+		Target:  []string{},
+		Refresh: BotStatus(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotAliasAvailable(conn *lexmodelsv2.LexModelsV2, botAliasID, botID string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotAliasStatusCreating},
+		Target:  []string{lexmodelsv2.BotAliasStatusAvailable},
+		Refresh: BotAliasStatus(conn, botAliasID, botID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotAliasDeleted(conn *lexmodelsv2.LexModelsV2, botAliasID, botID string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotAliasStatusAvailable, lexmodelsv2.BotAliasStatusDeleting},
+		Target:  []string{},
+		Refresh: BotAliasStatus(conn, botAliasID, botID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotLocaleCreated(conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusCreating},
+		Target:  []string{lexmodelsv2.BotLocaleStatusBuilt, lexmodelsv2.BotLocaleStatusNotBuilt, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Refresh: BotLocaleStatus(conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if status := aws.StringValue(output.BotLocaleStatus); status == lexmodelsv2.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotLocaleBuilt(conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusBuilding, lexmodelsv2.BotLocaleStatusNotBuilt, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Target:  []string{lexmodelsv2.BotLocaleStatusBuilt},
+		Refresh: BotLocaleStatus(conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		if status := aws.StringValue(output.BotLocaleStatus); status == lexmodelsv2.BotLocaleStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotLocaleDeleted(conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			lexmodelsv2.BotLocaleStatusBuilt,
+			lexmodelsv2.BotLocaleStatusDeleting,
+			lexmodelsv2.BotLocaleStatusNotBuilt,
+			lexmodelsv2.BotLocaleStatusReadyExpressTesting,
+		},
+		Target:  []string{},
+		Refresh: BotLocaleStatus(conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotVersionAvailable(conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusCreating, lexmodelsv2.BotStatusVersioning},
+		Target:  []string{lexmodelsv2.BotStatusAvailable},
+		Refresh: BotVersionStatus(conn, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func BotVersionDeleted(conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusAvailable, lexmodelsv2.BotStatusDeleting},
+		Target:  []string{},
+		Refresh: BotVersionStatus(conn, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
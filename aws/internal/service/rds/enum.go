@@ -5,3 +5,15 @@ const (
 	DBClusterRoleStatusDeleted = "DELETED"
 	DBClusterRoleStatusPending = "PENDING"
 )
+
+const (
+	DBInstanceStatusAvailable           = "available"
+	DBInstanceStatusBackingUp           = "backing-up"
+	DBInstanceStatusConfiguringLogs     = "configuring-log-exports"
+	DBInstanceStatusConfiguringMonitors = "configuring-enhanced-monitoring"
+	DBInstanceStatusMaintenance         = "maintenance"
+	DBInstanceStatusModifying           = "modifying"
+	DBInstanceStatusRebooting           = "rebooting"
+	DBInstanceStatusStorageOptimization = "storage-optimization"
+	DBInstanceStatusUpgrading           = "upgrading"
+)
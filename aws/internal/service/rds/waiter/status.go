@@ -1,9 +1,12 @@
 package waiter
 
 import (
+	"log"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
@@ -75,3 +78,31 @@ func DBClusterRoleStatus(conn *rds.RDS, dbClusterID, roleARN string) resource.St
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+// DBInstanceMultiAZStatus fetches the DBInstance and reports it as modifying
+// until its Multi-AZ setting matches the requested value.
+func DBInstanceMultiAZStatus(conn *rds.RDS, id string, multiAZ bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DBInstanceByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.DBInstanceStatus)
+
+		if status == tfrds.DBInstanceStatusAvailable {
+			if aws.BoolValue(output.MultiAZ) != multiAZ || (output.PendingModifiedValues != nil && output.PendingModifiedValues.MultiAZ != nil) {
+				status = tfrds.DBInstanceStatusModifying
+			}
+		}
+
+		log.Printf("[INFO] RDS DB Instance (%s) Multi-AZ conversion status: %s", id, status)
+
+		return output, status, nil
+	}
+}
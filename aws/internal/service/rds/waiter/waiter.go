@@ -107,3 +107,34 @@ func DBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN string)
 
 	return nil, err
 }
+
+// DBInstanceMultiAZUpdated waits for a DBInstance Multi-AZ conversion to complete
+func DBInstanceMultiAZUpdated(conn *rds.RDS, id string, multiAZ bool, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			tfrds.DBInstanceStatusBackingUp,
+			tfrds.DBInstanceStatusConfiguringLogs,
+			tfrds.DBInstanceStatusConfiguringMonitors,
+			tfrds.DBInstanceStatusMaintenance,
+			tfrds.DBInstanceStatusModifying,
+			tfrds.DBInstanceStatusRebooting,
+			tfrds.DBInstanceStatusUpgrading,
+		},
+		Target: []string{
+			tfrds.DBInstanceStatusAvailable,
+			tfrds.DBInstanceStatusStorageOptimization,
+		},
+		Refresh:    DBInstanceMultiAZStatus(conn, id, multiAZ),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
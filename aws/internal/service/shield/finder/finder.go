@@ -0,0 +1,83 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// EmergencyContacts returns the emergency contacts of the account's Shield Advanced subscription.
+func EmergencyContacts(conn *shield.Shield) ([]*shield.EmergencyContact, error) {
+	input := &shield.DescribeEmergencyContactSettingsInput{}
+
+	output, err := conn.DescribeEmergencyContactSettings(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EmergencyContactList, nil
+}
+
+// ProtectionByID returns the Shield Advanced protection corresponding to the specified ID.
+func ProtectionByID(conn *shield.Shield, id string) (*shield.Protection, error) {
+	input := &shield.DescribeProtectionInput{
+		ProtectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeProtection(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Protection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Protection, nil
+}
+
+// Subscription returns the account's Shield Advanced subscription.
+func Subscription(conn *shield.Shield) (*shield.Subscription, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscription(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscription, nil
+}
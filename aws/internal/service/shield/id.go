@@ -0,0 +1,25 @@
+package shield
+
+import (
+	"fmt"
+	"strings"
+)
+
+const protectionHealthCheckAssociationIDSeparator = ","
+
+func ProtectionHealthCheckAssociationCreateID(protectionID, healthCheckARN string) string {
+	parts := []string{protectionID, healthCheckARN}
+	id := strings.Join(parts, protectionHealthCheckAssociationIDSeparator)
+
+	return id
+}
+
+func ProtectionHealthCheckAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, protectionHealthCheckAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected protection-id%shealth-check-arn", id, protectionHealthCheckAssociationIDSeparator)
+}
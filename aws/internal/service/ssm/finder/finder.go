@@ -59,3 +59,35 @@ func PatchGroup(conn *ssm.SSM, patchGroup, baselineId string) (*ssm.PatchGroupPa
 
 	return result, err
 }
+
+// ParametersByPath returns all SSM Parameters, decrypted, in the hierarchy under the specified path.
+func ParametersByPath(conn *ssm.SSM, path string) ([]*ssm.Parameter, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	var output []*ssm.Parameter
+
+	err := conn.GetParametersByPathPages(input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, parameter := range page.Parameters {
+			if parameter == nil {
+				continue
+			}
+
+			output = append(output, parameter)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -69,3 +69,57 @@ func ManagedPolicy(conn *ssoadmin.SSOAdmin, managedPolicyArn, permissionSetArn,
 
 	return attachedPolicy, err
 }
+
+// AccountAssignmentsByPermissionSet returns all accounts assigned to a permission set within a specified SSO instance.
+func AccountAssignmentsByPermissionSet(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) ([]*ssoadmin.AccountAssignment, error) {
+	input := &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	var accountIDs []string
+	err := conn.ListAccountsForProvisionedPermissionSetPages(input, func(page *ssoadmin.ListAccountsForProvisionedPermissionSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		accountIDs = append(accountIDs, aws.StringValueSlice(page.AccountIds)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var accountAssignments []*ssoadmin.AccountAssignment
+	for _, accountID := range accountIDs {
+		input := &ssoadmin.ListAccountAssignmentsInput{
+			AccountId:        aws.String(accountID),
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+		}
+
+		err := conn.ListAccountAssignmentsPages(input, func(page *ssoadmin.ListAccountAssignmentsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, a := range page.AccountAssignments {
+				if a == nil {
+					continue
+				}
+
+				accountAssignments = append(accountAssignments, a)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accountAssignments, nil
+}
@@ -12,6 +12,7 @@ const (
 	AWSSSOAdminAccountAssignmentDeleteTimeout      = 5 * time.Minute
 	AWSSSOAdminAccountAssignmentDelay              = 5 * time.Second
 	AWSSSOAdminAccountAssignmentMinTimeout         = 3 * time.Second
+	AWSSSOAdminAccountAssignmentWaiterConcurrency  = 20
 	AWSSSOAdminPermissionSetProvisioningRetryDelay = 5 * time.Second
 	AWSSSOAdminPermissionSetProvisionTimeout       = 10 * time.Minute
 )
@@ -181,6 +181,13 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"strict_deprecations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["strict_deprecations"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -220,15 +227,18 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_origin_request_policy":           dataSourceAwsCloudFrontOriginRequestPolicy(),
 			"aws_cloudhsm_v2_cluster":                        dataSourceCloudHsmV2Cluster(),
 			"aws_cloudtrail_service_account":                 dataSourceAwsCloudTrailServiceAccount(),
+			"aws_cloudwatch_dashboard_document":              dataSourceAwsCloudWatchDashboardDocument(),
 			"aws_cloudwatch_event_connection":                dataSourceAwsCloudwatchEventConnection(),
 			"aws_cloudwatch_event_source":                    dataSourceAwsCloudWatchEventSource(),
 			"aws_cloudwatch_log_group":                       dataSourceAwsCloudwatchLogGroup(),
 			"aws_codeartifact_authorization_token":           dataSourceAwsCodeArtifactAuthorizationToken(),
 			"aws_codeartifact_repository_endpoint":           dataSourceAwsCodeArtifactRepositoryEndpoint(),
+			"aws_codestarnotifications_event_types":          dataSourceAwsCodeStarNotificationsEventTypes(),
 			"aws_cognito_user_pools":                         dataSourceAwsCognitoUserPools(),
 			"aws_codecommit_repository":                      dataSourceAwsCodeCommitRepository(),
 			"aws_codestarconnections_connection":             dataSourceAwsCodeStarConnectionsConnection(),
 			"aws_cur_report_definition":                      dataSourceAwsCurReportDefinition(),
+			"aws_dax_cluster":                                dataSourceAwsDaxCluster(),
 			"aws_default_tags":                               dataSourceAwsDefaultTags(),
 			"aws_db_cluster_snapshot":                        dataSourceAwsDbClusterSnapshot(),
 			"aws_db_event_categories":                        dataSourceAwsDbEventCategories(),
@@ -288,6 +298,7 @@ func Provider() *schema.Provider {
 			"aws_elastic_beanstalk_solution_stack":           dataSourceAwsElasticBeanstalkSolutionStack(),
 			"aws_elasticache_cluster":                        dataSourceAwsElastiCacheCluster(),
 			"aws_elasticache_replication_group":              dataSourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_reserved_cache_node_offering":   dataSourceAwsElasticacheReservedCacheNodeOffering(),
 			"aws_elasticache_user":                           dataSourceAwsElastiCacheUser(),
 			"aws_elasticsearch_domain":                       dataSourceAwsElasticSearchDomain(),
 			"aws_elb":                                        dataSourceAwsElb(),
@@ -345,6 +356,7 @@ func Provider() *schema.Provider {
 			"aws_lex_intent":                                 dataSourceAwsLexIntent(),
 			"aws_lex_slot_type":                              dataSourceAwsLexSlotType(),
 			"aws_mq_broker":                                  dataSourceAwsMqBroker(),
+			"aws_mq_broker_engine_types":                     dataSourceAwsMqBrokerEngineTypes(),
 			"aws_msk_cluster":                                dataSourceAwsMskCluster(),
 			"aws_msk_configuration":                          dataSourceAwsMskConfiguration(),
 			"aws_nat_gateway":                                dataSourceAwsNatGateway(),
@@ -355,6 +367,7 @@ func Provider() *schema.Provider {
 			"aws_network_interfaces":                         dataSourceAwsNetworkInterfaces(),
 			"aws_organizations_delegated_administrators":     dataSourceAwsOrganizationsDelegatedAdministrators(),
 			"aws_organizations_delegated_services":           dataSourceAwsOrganizationsDelegatedServices(),
+			"aws_organizations_effective_policy":             dataSourceAwsOrganizationsEffectivePolicy(),
 			"aws_organizations_organization":                 dataSourceAwsOrganizationsOrganization(),
 			"aws_organizations_organizational_units":         dataSourceAwsOrganizationsOrganizationalUnits(),
 			"aws_outposts_outpost":                           dataSourceAwsOutpostsOutpost(),
@@ -364,6 +377,7 @@ func Provider() *schema.Provider {
 			"aws_outposts_site":                              dataSourceAwsOutpostsSite(),
 			"aws_outposts_sites":                             dataSourceAwsOutpostsSites(),
 			"aws_partition":                                  dataSourceAwsPartition(),
+			"aws_partition_capabilities":                     dataSourceAwsPartitionCapabilities(),
 			"aws_prefix_list":                                dataSourceAwsPrefixList(),
 			"aws_pricing_product":                            dataSourceAwsPricingProduct(),
 			"aws_qldb_ledger":                                dataSourceAwsQLDBLedger(),
@@ -377,6 +391,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":                   dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                                     dataSourceAwsRegion(),
 			"aws_regions":                                    dataSourceAwsRegions(),
+			"aws_resourcegroups_group_resources":             dataSourceAwsResourceGroupsGroupResources(),
 			"aws_resourcegroupstaggingapi_resources":         dataSourceAwsResourceGroupsTaggingAPIResources(),
 			"aws_route":                                      dataSourceAwsRoute(),
 			"aws_route_table":                                dataSourceAwsRouteTable(),
@@ -393,6 +408,7 @@ func Provider() *schema.Provider {
 			"aws_secretsmanager_secret":                      dataSourceAwsSecretsManagerSecret(),
 			"aws_secretsmanager_secret_rotation":             dataSourceAwsSecretsManagerSecretRotation(),
 			"aws_secretsmanager_secret_version":              dataSourceAwsSecretsManagerSecretVersion(),
+			"aws_service_principal":                          dataSourceAwsServicePrincipal(),
 			"aws_servicecatalog_constraint":                  dataSourceAwsServiceCatalogConstraint(),
 			"aws_servicecatalog_launch_paths":                dataSourceAwsServiceCatalogLaunchPaths(),
 			"aws_servicecatalog_portfolio_constraints":       dataSourceAwsServiceCatalogPortfolioConstraints(),
@@ -455,6 +471,7 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"aws_accessanalyzer_analyzer":                             resourceAwsAccessAnalyzerAnalyzer(),
+			"aws_accessanalyzer_archive_rule":                         resourceAwsAccessAnalyzerArchiveRule(),
 			"aws_acm_certificate":                                     resourceAwsAcmCertificate(),
 			"aws_acm_certificate_validation":                          resourceAwsAcmCertificateValidation(),
 			"aws_acmpca_certificate_authority":                        resourceAwsAcmpcaCertificateAuthority(),
@@ -515,6 +532,7 @@ func Provider() *schema.Provider {
 			"aws_appconfig_deployment_strategy":                       resourceAwsAppconfigDeploymentStrategy(),
 			"aws_appconfig_environment":                               resourceAwsAppconfigEnvironment(),
 			"aws_appconfig_hosted_configuration_version":              resourceAwsAppconfigHostedConfigurationVersion(),
+			"aws_appintegrations_event_integration":                   resourceAwsAppIntegrationsEventIntegration(),
 			"aws_appmesh_gateway_route":                               resourceAwsAppmeshGatewayRoute(),
 			"aws_appmesh_mesh":                                        resourceAwsAppmeshMesh(),
 			"aws_appmesh_route":                                       resourceAwsAppmeshRoute(),
@@ -534,6 +552,10 @@ func Provider() *schema.Provider {
 			"aws_athena_database":                                     resourceAwsAthenaDatabase(),
 			"aws_athena_named_query":                                  resourceAwsAthenaNamedQuery(),
 			"aws_athena_workgroup":                                    resourceAwsAthenaWorkgroup(),
+			"aws_auditmanager_assessment":                             resourceAwsAuditManagerAssessment(),
+			"aws_auditmanager_assessment_report":                      resourceAwsAuditManagerAssessmentReport(),
+			"aws_auditmanager_control":                                resourceAwsAuditManagerControl(),
+			"aws_auditmanager_framework":                              resourceAwsAuditManagerFramework(),
 			"aws_autoscaling_attachment":                              resourceAwsAutoscalingAttachment(),
 			"aws_autoscaling_group":                                   resourceAwsAutoscalingGroup(),
 			"aws_autoscaling_group_tag":                               resourceAwsAutoscalingGroupTag(),
@@ -568,6 +590,7 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
 			"aws_cloudfront_realtime_log_config":                      resourceAwsCloudFrontRealtimeLogConfig(),
 			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudwatch_anomaly_detector":                         resourceAwsCloudWatchAnomalyDetector(),
 			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
 			"aws_cloudwatch_event_bus_policy":                         resourceAwsCloudWatchEventBusPolicy(),
 			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
@@ -630,6 +653,9 @@ func Provider() *schema.Provider {
 			"aws_codestarnotifications_notification_rule":             resourceAwsCodeStarNotificationsNotificationRule(),
 			"aws_cur_report_definition":                               resourceAwsCurReportDefinition(),
 			"aws_customer_gateway":                                    resourceAwsCustomerGateway(),
+			"aws_customerprofiles_domain":                             resourceAwsCustomerProfilesDomain(),
+			"aws_customerprofiles_integration":                        resourceAwsCustomerProfilesIntegration(),
+			"aws_customerprofiles_profile_object_type":                resourceAwsCustomerProfilesProfileObjectType(),
 			"aws_datapipeline_pipeline":                               resourceAwsDataPipelinePipeline(),
 			"aws_datasync_agent":                                      resourceAwsDataSyncAgent(),
 			"aws_datasync_location_efs":                               resourceAwsDataSyncLocationEfs(),
@@ -687,6 +713,7 @@ func Provider() *schema.Provider {
 			"aws_dx_public_virtual_interface":                         resourceAwsDxPublicVirtualInterface(),
 			"aws_dx_transit_virtual_interface":                        resourceAwsDxTransitVirtualInterface(),
 			"aws_dynamodb_table":                                      resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_export":                               resourceAwsDynamoDbTableExport(),
 			"aws_dynamodb_table_item":                                 resourceAwsDynamoDbTableItem(),
 			"aws_dynamodb_tag":                                        resourceAwsDynamodbTag(),
 			"aws_dynamodb_global_table":                               resourceAwsDynamoDbGlobalTable(),
@@ -751,6 +778,7 @@ func Provider() *schema.Provider {
 			"aws_elasticache_global_replication_group":                resourceAwsElasticacheGlobalReplicationGroup(),
 			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
 			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_reserved_cache_node":                     resourceAwsElasticacheReservedCacheNode(),
 			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
 			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
 			"aws_elasticache_user":                                    resourceAwsElasticacheUser(),
@@ -771,7 +799,17 @@ func Provider() *schema.Provider {
 			"aws_emr_instance_fleet":                                  resourceAwsEMRInstanceFleet(),
 			"aws_emr_managed_scaling_policy":                          resourceAwsEMRManagedScalingPolicy(),
 			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
+			"aws_emrcontainers_virtual_cluster":                       resourceAwsEMRContainersVirtualCluster(),
 			"aws_flow_log":                                            resourceAwsFlowLog(),
+			"aws_frauddetector_detector":                              resourceAwsFraudDetectorDetector(),
+			"aws_frauddetector_entity_type":                           resourceAwsFraudDetectorEntityType(),
+			"aws_frauddetector_event_type":                            resourceAwsFraudDetectorEventType(),
+			"aws_frauddetector_label":                                 resourceAwsFraudDetectorLabel(),
+			"aws_frauddetector_model":                                 resourceAwsFraudDetectorModel(),
+			"aws_frauddetector_model_version":                         resourceAwsFraudDetectorModelVersion(),
+			"aws_frauddetector_outcome":                               resourceAwsFraudDetectorOutcome(),
+			"aws_frauddetector_rule":                                  resourceAwsFraudDetectorRule(),
+			"aws_frauddetector_variable":                              resourceAwsFraudDetectorVariable(),
 			"aws_fsx_backup":                                          resourceAwsFsxBackup(),
 			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
 			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
@@ -812,6 +850,8 @@ func Provider() *schema.Provider {
 			"aws_guardduty_organization_configuration":                resourceAwsGuardDutyOrganizationConfiguration(),
 			"aws_guardduty_publishing_destination":                    resourceAwsGuardDutyPublishingDestination(),
 			"aws_guardduty_threatintelset":                            resourceAwsGuardDutyThreatintelset(),
+			"aws_healthlake_fhir_datastore":                           resourceAwsHealthLakeFHIRDatastore(),
+			"aws_healthlake_fhir_import_job":                          resourceAwsHealthLakeFHIRImportJob(),
 			"aws_iam_access_key":                                      resourceAwsIamAccessKey(),
 			"aws_iam_account_alias":                                   resourceAwsIamAccountAlias(),
 			"aws_iam_account_password_policy":                         resourceAwsIamAccountPasswordPolicy(),
@@ -823,9 +863,11 @@ func Provider() *schema.Provider {
 			"aws_iam_openid_connect_provider":                         resourceAwsIamOpenIDConnectProvider(),
 			"aws_iam_policy":                                          resourceAwsIamPolicy(),
 			"aws_iam_policy_attachment":                               resourceAwsIamPolicyAttachment(),
+			"aws_iam_role_policies_exclusive":                         resourceAwsIamRolePoliciesExclusive(),
 			"aws_iam_role_policy_attachment":                          resourceAwsIamRolePolicyAttachment(),
 			"aws_iam_role_policy":                                     resourceAwsIamRolePolicy(),
 			"aws_iam_role":                                            resourceAwsIamRole(),
+			"aws_iam_role_policy_attachments_exclusive":               resourceAwsIamRolePolicyAttachmentsExclusive(),
 			"aws_iam_saml_provider":                                   resourceAwsIamSamlProvider(),
 			"aws_iam_server_certificate":                              resourceAwsIAMServerCertificate(),
 			"aws_iam_service_linked_role":                             resourceAwsIamServiceLinkedRole(),
@@ -867,8 +909,10 @@ func Provider() *schema.Provider {
 			"aws_kms_grant":                                           resourceAwsKmsGrant(),
 			"aws_kms_key":                                             resourceAwsKmsKey(),
 			"aws_kms_ciphertext":                                      resourceAwsKmsCiphertext(),
+			"aws_kms_replica_key":                                     resourceAwsKmsReplicaKey(),
 			"aws_lakeformation_data_lake_settings":                    resourceAwsLakeFormationDataLakeSettings(),
 			"aws_lakeformation_permissions":                           resourceAwsLakeFormationPermissions(),
+			"aws_lakeformation_permissions_batch":                     resourceAwsLakeFormationPermissionsBatch(),
 			"aws_lakeformation_resource":                              resourceAwsLakeFormationResource(),
 			"aws_lambda_alias":                                        resourceAwsLambdaAlias(),
 			"aws_lambda_code_signing_config":                          resourceAwsLambdaCodeSigningConfig(),
@@ -884,6 +928,13 @@ func Provider() *schema.Provider {
 			"aws_lex_bot_alias":                                       resourceAwsLexBotAlias(),
 			"aws_lex_intent":                                          resourceAwsLexIntent(),
 			"aws_lex_slot_type":                                       resourceAwsLexSlotType(),
+			"aws_lexv2models_bot":                                     resourceAwsLexV2ModelsBot(),
+			"aws_lexv2models_bot_alias":                               resourceAwsLexV2ModelsBotAlias(),
+			"aws_lexv2models_bot_locale":                              resourceAwsLexV2ModelsBotLocale(),
+			"aws_lexv2models_bot_version":                             resourceAwsLexV2ModelsBotVersion(),
+			"aws_lexv2models_intent":                                  resourceAwsLexV2ModelsIntent(),
+			"aws_lexv2models_slot":                                    resourceAwsLexV2ModelsSlot(),
+			"aws_lexv2models_slot_type":                               resourceAwsLexV2ModelsSlotType(),
 			"aws_licensemanager_association":                          resourceAwsLicenseManagerAssociation(),
 			"aws_licensemanager_license_configuration":                resourceAwsLicenseManagerLicenseConfiguration(),
 			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
@@ -1102,8 +1153,10 @@ func Provider() *schema.Provider {
 			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
 			"aws_service_discovery_service":                           resourceAwsServiceDiscoveryService(),
 			"aws_servicequotas_service_quota":                         resourceAwsServiceQuotasServiceQuota(),
+			"aws_shield_proactive_engagement":                         resourceAwsShieldProactiveEngagement(),
 			"aws_shield_protection":                                   resourceAwsShieldProtection(),
 			"aws_shield_protection_group":                             resourceAwsShieldProtectionGroup(),
+			"aws_shield_protection_health_check_association":          resourceAwsShieldProtectionHealthCheckAssociation(),
 			"aws_signer_signing_job":                                  resourceAwsSignerSigningJob(),
 			"aws_signer_signing_profile":                              resourceAwsSignerSigningProfile(),
 			"aws_signer_signing_profile_permission":                   resourceAwsSignerSigningProfilePermission(),
@@ -1117,8 +1170,10 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_baseline":                                  resourceAwsSsmPatchBaseline(),
 			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
 			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
+			"aws_ssm_parameters":                                      resourceAwsSsmParameters(),
 			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
 			"aws_ssoadmin_account_assignment":                         resourceAwsSsoAdminAccountAssignment(),
+			"aws_ssoadmin_account_assignments":                        resourceAwsSsoAdminAccountAssignments(),
 			"aws_ssoadmin_managed_policy_attachment":                  resourceAwsSsoAdminManagedPolicyAttachment(),
 			"aws_ssoadmin_permission_set":                             resourceAwsSsoAdminPermissionSet(),
 			"aws_ssoadmin_permission_set_inline_policy":               resourceAwsSsoAdminPermissionSetInlinePolicy(),
@@ -1253,6 +1308,10 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	for _, r := range provider.ResourcesMap {
+		addStrictDeprecationsCustomizeDiff(r)
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1317,6 +1376,9 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"strict_deprecations": "Set this to true to return an error during plan, instead of a warning,\n" +
+			"when a deprecated resource argument is configured.",
 	}
 
 	endpointServiceNames = []string{
@@ -1326,6 +1388,7 @@ func init() {
 		"amplify",
 		"apigateway",
 		"appconfig",
+		"appintegrations",
 		"applicationautoscaling",
 		"applicationinsights",
 		"appmesh",
@@ -1360,6 +1423,7 @@ func init() {
 		"configservice",
 		"connect",
 		"cur",
+		"customerprofiles",
 		"dataexchange",
 		"datapipeline",
 		"datasync",
@@ -1388,6 +1452,7 @@ func init() {
 		"firehose",
 		"fms",
 		"forecast",
+		"frauddetector",
 		"fsx",
 		"gamelift",
 		"glacier",
@@ -1395,6 +1460,7 @@ func init() {
 		"glue",
 		"greengrass",
 		"guardduty",
+		"healthlake",
 		"iam",
 		"identitystore",
 		"imagebuilder",
@@ -1411,6 +1477,7 @@ func init() {
 		"lakeformation",
 		"lambda",
 		"lexmodels",
+		"lexmodelsv2",
 		"licensemanager",
 		"lightsail",
 		"location",
@@ -1503,6 +1570,7 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		StrictDeprecations:      d.Get("strict_deprecations").(bool),
 		terraformVersion:        terraformVersion,
 	}
 
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appintegrations/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppIntegrationsEventIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppIntegrationsEventIntegrationCreate,
+		Read:   resourceAwsAppIntegrationsEventIntegrationRead,
+		Update: resourceAwsAppIntegrationsEventIntegrationUpdate,
+		Delete: resourceAwsAppIntegrationsEventIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"event_filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 256),
+								validation.StringMatch(regexp.MustCompile(`^aws\.partner\/.*$`), "must begin with 'aws.partner/'"),
+							),
+						},
+					},
+				},
+			},
+			"eventbridge_bus": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\/\._\-]+$`), "must contain only alphanumeric, forward slash, period, underscore and hyphen characters"),
+				),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\.\-]+$`), "must contain only alphanumeric, period, underscore and hyphen characters"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsAppIntegrationsEventIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appintegrationsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appintegrationsservice.CreateEventIntegrationInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		EventBridgeBus: aws.String(d.Get("eventbridge_bus").(string)),
+		EventFilter:    expandAppIntegrationsEventFilter(d.Get("event_filter").([]interface{})),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AppintegrationsserviceTags()
+	}
+
+	log.Printf("[DEBUG] Creating AppIntegrations Event Integration: %s", input)
+	_, err := conn.CreateEventIntegration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppIntegrations Event Integration (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsAppIntegrationsEventIntegrationRead(d, meta)
+}
+
+func resourceAwsAppIntegrationsEventIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appintegrationsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.EventIntegrationByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Event Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppIntegrations Event Integration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.EventIntegrationArn)
+	d.Set("description", output.Description)
+	if err := d.Set("event_filter", flattenAppIntegrationsEventFilter(output.EventFilter)); err != nil {
+		return fmt.Errorf("error setting event_filter: %w", err)
+	}
+	d.Set("eventbridge_bus", output.EventBridgeBus)
+	d.Set("name", output.Name)
+
+	tags := keyvaluetags.AppintegrationsserviceKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppIntegrationsEventIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appintegrationsconn
+
+	if d.HasChange("description") {
+		input := &appintegrationsservice.UpdateEventIntegrationInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating AppIntegrations Event Integration: %s", input)
+		_, err := conn.UpdateEventIntegration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppIntegrations Event Integration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AppintegrationsserviceUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppIntegrations Event Integration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppIntegrationsEventIntegrationRead(d, meta)
+}
+
+func resourceAwsAppIntegrationsEventIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appintegrationsconn
+
+	log.Printf("[DEBUG] Deleting AppIntegrations Event Integration: %s", d.Id())
+	_, err := conn.DeleteEventIntegration(&appintegrationsservice.DeleteEventIntegrationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppIntegrations Event Integration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAppIntegrationsEventFilter(tfList []interface{}) *appintegrationsservice.EventFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &appintegrationsservice.EventFilter{
+		Source: aws.String(tfMap["source"].(string)),
+	}
+}
+
+func flattenAppIntegrationsEventFilter(apiObject *appintegrationsservice.EventFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"source": aws.StringValue(apiObject.Source),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appintegrations/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAppIntegrationsEventIntegration_basic(t *testing.T) {
+	var v appintegrationsservice.GetEventIntegrationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appintegrations_event_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppIntegrationsEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppIntegrationsEventIntegrationConfig(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppIntegrationsEventIntegrationExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "app-integrations", regexp.MustCompile(`event-integration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "event_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_filter.0.source", "aws.partner/examplepartner.com"),
+					resource.TestCheckResourceAttr(resourceName, "eventbridge_bus", "default"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppIntegrationsEventIntegrationConfig(rName, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppIntegrationsEventIntegrationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppIntegrationsEventIntegration_disappears(t *testing.T) {
+	var v appintegrationsservice.GetEventIntegrationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appintegrations_event_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppIntegrationsEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppIntegrationsEventIntegrationConfig(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppIntegrationsEventIntegrationExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppIntegrationsEventIntegration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppIntegrationsEventIntegration_tags(t *testing.T) {
+	var v appintegrationsservice.GetEventIntegrationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appintegrations_event_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(appintegrationsservice.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, appintegrationsservice.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppIntegrationsEventIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppIntegrationsEventIntegrationConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppIntegrationsEventIntegrationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppIntegrationsEventIntegrationConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppIntegrationsEventIntegrationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppIntegrationsEventIntegrationConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppIntegrationsEventIntegrationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppIntegrationsEventIntegrationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appintegrationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appintegrations_event_integration" {
+			continue
+		}
+
+		_, err := finder.EventIntegrationByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppIntegrations Event Integration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppIntegrationsEventIntegrationExists(n string, v *appintegrationsservice.GetEventIntegrationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppIntegrations Event Integration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appintegrationsconn
+
+		output, err := finder.EventIntegrationByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSAppIntegrationsEventIntegrationConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = "aws.partner/examplepartner.com"
+  }
+}
+`, rName, description)
+}
+
+func testAccAWSAppIntegrationsEventIntegrationConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = "aws.partner/examplepartner.com"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppIntegrationsEventIntegrationConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appintegrations_event_integration" "test" {
+  name            = %[1]q
+  eventbridge_bus = "default"
+
+  event_filter {
+    source = "aws.partner/examplepartner.com"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -20,7 +20,7 @@ func resourceAwsAthenaWorkgroup() *schema.Resource {
 		Update: resourceAwsAthenaWorkgroupUpdate,
 		Delete: resourceAwsAthenaWorkgroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("workgroup"),
 		},
 
 		Schema: map[string]*schema.Schema{
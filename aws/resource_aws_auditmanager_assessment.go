@@ -0,0 +1,465 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAuditManagerAssessment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAuditManagerAssessmentCreate,
+		Read:   resourceAwsAuditManagerAssessmentRead,
+		Update: resourceAwsAuditManagerAssessmentUpdate,
+		Delete: resourceAwsAuditManagerAssessmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_reports_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"destination_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.AssessmentReportDestinationType_Values(), false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"framework_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"role_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.RoleType_Values(), false),
+						},
+					},
+				},
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+								},
+							},
+						},
+						"aws_services": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 40),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+}
+
+func resourceAwsAuditManagerAssessmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentInput{
+		FrameworkId: aws.String(d.Get("framework_id").(string)),
+		Name:        aws.String(name),
+		Roles:       expandAuditManagerRoles(d.Get("roles").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("assessment_reports_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AssessmentReportsDestination = expandAuditManagerAssessmentReportsDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scope"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Scope = expandAuditManagerScope(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AuditmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Assessment: %s", input)
+	output, err := conn.CreateAssessment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Audit Manager Assessment (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assessment.Metadata.Id))
+
+	return resourceAwsAuditManagerAssessmentRead(d, meta)
+}
+
+func resourceAwsAuditManagerAssessmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	assessment, err := finder.AssessmentByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager Assessment (%s): %w", d.Id(), err)
+	}
+
+	metadata := assessment.Metadata
+
+	d.Set("arn", assessment.Arn)
+	if metadata.AssessmentReportsDestination != nil {
+		if err := d.Set("assessment_reports_destination", []interface{}{flattenAuditManagerAssessmentReportsDestination(metadata.AssessmentReportsDestination)}); err != nil {
+			return fmt.Errorf("error setting assessment_reports_destination: %w", err)
+		}
+	} else {
+		d.Set("assessment_reports_destination", nil)
+	}
+	d.Set("description", metadata.Description)
+	if assessment.Framework != nil {
+		d.Set("framework_id", assessment.Framework.Id)
+	} else {
+		d.Set("framework_id", nil)
+	}
+	d.Set("name", metadata.Name)
+	if err := d.Set("roles", flattenAuditManagerRoles(metadata.Roles)); err != nil {
+		return fmt.Errorf("error setting roles: %w", err)
+	}
+	if metadata.Scope != nil {
+		if err := d.Set("scope", []interface{}{flattenAuditManagerScope(metadata.Scope)}); err != nil {
+			return fmt.Errorf("error setting scope: %w", err)
+		}
+	} else {
+		d.Set("scope", nil)
+	}
+	d.Set("status", metadata.Status)
+
+	tags := keyvaluetags.AuditmanagerKeyValueTags(assessment.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAuditManagerAssessmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &auditmanager.UpdateAssessmentInput{
+			AssessmentId:   aws.String(d.Id()),
+			AssessmentName: aws.String(d.Get("name").(string)),
+			Roles:          expandAuditManagerRoles(d.Get("roles").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("assessment_reports_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AssessmentReportsDestination = expandAuditManagerAssessmentReportsDestination(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.AssessmentDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("scope"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Scope = expandAuditManagerScope(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Assessment: %s", input)
+		_, err := conn.UpdateAssessment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Audit Manager Assessment (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AuditmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Audit Manager Assessment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAuditManagerAssessmentRead(d, meta)
+}
+
+func resourceAwsAuditManagerAssessmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Assessment: %s", d.Id())
+	_, err := conn.DeleteAssessment(&auditmanager.DeleteAssessmentInput{
+		AssessmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Audit Manager Assessment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAuditManagerAssessmentReportsDestination(tfMap map[string]interface{}) *auditmanager.AssessmentReportsDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.AssessmentReportsDestination{}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_type"].(string); ok && v != "" {
+		apiObject.DestinationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAuditManagerRoles(tfList []interface{}) []*auditmanager.Role {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.Role
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.Role{}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["role_type"].(string); ok && v != "" {
+			apiObject.RoleType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAuditManagerScope(tfMap map[string]interface{}) *auditmanager.Scope {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.Scope{}
+
+	if v, ok := tfMap["aws_accounts"].(*schema.Set); ok && v.Len() > 0 {
+		for _, accountRaw := range v.List() {
+			account, ok := accountRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AwsAccounts = append(apiObject.AwsAccounts, &auditmanager.AWSAccount{
+				Id: aws.String(account["id"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["aws_services"].(*schema.Set); ok && v.Len() > 0 {
+		for _, serviceRaw := range v.List() {
+			service, ok := serviceRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AwsServices = append(apiObject.AwsServices, &auditmanager.AWSService{
+				ServiceName: aws.String(service["service_name"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAuditManagerAssessmentReportsDestination(apiObject *auditmanager.AssessmentReportsDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DestinationType; v != nil {
+		tfMap["destination_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAuditManagerRoles(apiObjects []*auditmanager.Role) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.RoleArn; v != nil {
+			tfMap["role_arn"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.RoleType; v != nil {
+			tfMap["role_type"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAuditManagerScope(apiObject *auditmanager.Scope) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AwsAccounts; v != nil {
+		var tfList []interface{}
+
+		for _, account := range v {
+			if account == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"id": aws.StringValue(account.Id),
+			})
+		}
+
+		tfMap["aws_accounts"] = tfList
+	}
+
+	if v := apiObject.AwsServices; v != nil {
+		var tfList []interface{}
+
+		for _, service := range v {
+			if service == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"service_name": aws.StringValue(service.ServiceName),
+			})
+		}
+
+		tfMap["aws_services"] = tfList
+	}
+
+	return tfMap
+}
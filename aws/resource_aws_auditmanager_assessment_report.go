@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfauditmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAuditManagerAssessmentReport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAuditManagerAssessmentReportCreate,
+		Read:   resourceAwsAuditManagerAssessmentReportRead,
+		Delete: resourceAwsAuditManagerAssessmentReportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAuditManagerAssessmentReportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+
+	assessmentID := d.Get("assessment_id").(string)
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentReportInput{
+		AssessmentId: aws.String(assessmentID),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Assessment Report: %s", input)
+	output, err := conn.CreateAssessmentReport(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Audit Manager Assessment Report (%s): %w", name, err)
+	}
+
+	reportID := aws.StringValue(output.AssessmentReport.Id)
+	d.SetId(tfauditmanager.AssessmentReportCreateID(assessmentID, reportID))
+
+	if _, err := waiter.AssessmentReportCreated(conn, assessmentID, reportID); err != nil {
+		return fmt.Errorf("error waiting for Audit Manager Assessment Report (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsAuditManagerAssessmentReportRead(d, meta)
+}
+
+func resourceAwsAuditManagerAssessmentReportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+
+	assessmentID, reportID, err := tfauditmanager.AssessmentReportParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	report, err := finder.AssessmentReportByTwoPartKey(conn, assessmentID, reportID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment Report (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager Assessment Report (%s): %w", d.Id(), err)
+	}
+
+	d.Set("assessment_id", report.AssessmentId)
+	d.Set("author", report.Author)
+	d.Set("description", report.Description)
+	d.Set("name", report.Name)
+	d.Set("status", report.Status)
+
+	return nil
+}
+
+func resourceAwsAuditManagerAssessmentReportDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+
+	assessmentID, reportID, err := tfauditmanager.AssessmentReportParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Audit Manager Assessment Report: %s", d.Id())
+	_, err = conn.DeleteAssessmentReport(&auditmanager.DeleteAssessmentReportInput{
+		AssessmentId:       aws.String(assessmentID),
+		AssessmentReportId: aws.String(reportID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Audit Manager Assessment Report (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
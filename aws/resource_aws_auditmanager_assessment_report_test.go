@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfauditmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAuditManagerAssessmentReport_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_assessment_report.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerAssessmentReportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerAssessmentReportConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerAssessmentReportExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "assessment_id", "aws_auditmanager_assessment.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "author"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", auditmanager.AssessmentReportStatusComplete),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAuditManagerAssessmentReport_disappears(t *testing.T) {
+	resourceName := "aws_auditmanager_assessment_report.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerAssessmentReportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerAssessmentReportConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerAssessmentReportExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAuditManagerAssessmentReport(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAuditManagerAssessmentReportDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_report" {
+			continue
+		}
+
+		assessmentID, reportID, err := tfauditmanager.AssessmentReportParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.AssessmentReportByTwoPartKey(conn, assessmentID, reportID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Assessment Report %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAuditManagerAssessmentReportExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Assessment Report ID is set")
+		}
+
+		assessmentID, reportID, err := tfauditmanager.AssessmentReportParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+		_, err = finder.AssessmentReportByTwoPartKey(conn, assessmentID, reportID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAuditManagerAssessmentReportConfig(rName string) string {
+	return composeConfig(testAccAuditManagerAssessmentConfig(rName), fmt.Sprintf(`
+resource "aws_auditmanager_assessment_report" "test" {
+  name          = %[1]q
+  description   = "test"
+  assessment_id = aws_auditmanager_assessment.test.id
+}
+`, rName))
+}
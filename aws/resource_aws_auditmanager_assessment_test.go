@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAuditManagerAssessment_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_assessment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerAssessmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerAssessmentExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "auditmanager", regexp.MustCompile(`assessment/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "assessment_reports_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "assessment_reports_destination.0.destination_type", auditmanager.AssessmentReportDestinationTypeS3),
+					resource.TestCheckResourceAttrPair(resourceName, "framework_id", "aws_auditmanager_framework.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "roles.*.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "scope.0.aws_accounts.*.id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", auditmanager.AssessmentStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAuditManagerAssessment_disappears(t *testing.T) {
+	resourceName := "aws_auditmanager_assessment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerAssessmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerAssessmentExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAuditManagerAssessment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAuditManagerAssessment_update(t *testing.T) {
+	resourceName := "aws_auditmanager_assessment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerAssessmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerAssessmentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAuditManagerAssessmentConfigUpdated(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_services.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scope.0.aws_services.*", map[string]string{
+						"service_name": "S3",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAuditManagerAssessmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment" {
+			continue
+		}
+
+		_, err := finder.AssessmentByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Assessment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAuditManagerAssessmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Assessment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+		_, err := finder.AssessmentByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAuditManagerAssessmentConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+}
+
+resource "aws_auditmanager_framework" "test" {
+  name = %[1]q
+
+  control_sets {
+    name = %[1]q
+
+    controls {
+      id = aws_auditmanager_control.test.id
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAuditManagerAssessmentConfig(rName string) string {
+	return composeConfig(testAccAuditManagerAssessmentConfigBase(rName), fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name         = %[1]q
+  framework_id = aws_auditmanager_framework.test.id
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAuditManagerAssessmentConfigUpdated(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAuditManagerAssessmentConfigBase(rName), fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name         = %[1]q
+  description  = "updated"
+  framework_id = aws_auditmanager_framework.test.id
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+
+    aws_services {
+      service_name = "S3"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
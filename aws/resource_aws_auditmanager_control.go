@@ -0,0 +1,492 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAuditManagerControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAuditManagerControlCreate,
+		Read:   resourceAwsAuditManagerControlRead,
+		Update: resourceAwsAuditManagerControlUpdate,
+		Delete: resourceAwsAuditManagerControlDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action_plan_instructions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"action_plan_title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 300),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_mapping_sources": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Set:      auditManagerControlMappingSourceHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+						"source_frequency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceFrequency_Values(), false),
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_keyword": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keyword_input_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(auditmanager.KeywordInputType_Values(), false),
+									},
+									"keyword_value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						"source_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"source_set_up_option": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceSetUpOption_Values(), false),
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceType_Values(), false),
+						},
+						"troubleshooting_text": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"testing_information": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+}
+
+func resourceAwsAuditManagerControlCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateControlInput{
+		ControlMappingSources: expandAuditManagerCreateControlMappingSources(d.Get("control_mapping_sources").(*schema.Set).List()),
+		Name:                  aws.String(name),
+	}
+
+	if v, ok := d.GetOk("action_plan_instructions"); ok {
+		input.ActionPlanInstructions = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("action_plan_title"); ok {
+		input.ActionPlanTitle = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("testing_information"); ok {
+		input.TestingInformation = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AuditmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Control: %s", input)
+	output, err := conn.CreateControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Audit Manager Control (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Control.Id))
+
+	return resourceAwsAuditManagerControlRead(d, meta)
+}
+
+func resourceAwsAuditManagerControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	control, err := finder.ControlByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Audit Manager Control (%s): %w", d.Id(), err)
+	}
+
+	d.Set("action_plan_instructions", control.ActionPlanInstructions)
+	d.Set("action_plan_title", control.ActionPlanTitle)
+	d.Set("arn", control.Arn)
+	if err := d.Set("control_mapping_sources", flattenAuditManagerControlMappingSources(control.ControlMappingSources)); err != nil {
+		return fmt.Errorf("error setting control_mapping_sources: %w", err)
+	}
+	d.Set("description", control.Description)
+	d.Set("name", control.Name)
+	d.Set("testing_information", control.TestingInformation)
+	d.Set("type", control.Type)
+
+	tags := keyvaluetags.AuditmanagerKeyValueTags(control.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAuditManagerControlUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// The update replaces the whole control, so all arguments are sent.
+		input := &auditmanager.UpdateControlInput{
+			ControlId:             aws.String(d.Id()),
+			ControlMappingSources: expandAuditManagerControlMappingSources(d.Get("control_mapping_sources").(*schema.Set).List()),
+			Name:                  aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("action_plan_instructions"); ok {
+			input.ActionPlanInstructions = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("action_plan_title"); ok {
+			input.ActionPlanTitle = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("testing_information"); ok {
+			input.TestingInformation = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Control: %s", input)
+		_, err := conn.UpdateControl(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Audit Manager Control (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AuditmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Audit Manager Control (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAuditManagerControlRead(d, meta)
+}
+
+func resourceAwsAuditManagerControlDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).auditmanagerconn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Control: %s", d.Id())
+	_, err := conn.DeleteControl(&auditmanager.DeleteControlInput{
+		ControlId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Audit Manager Control (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// auditManagerControlMappingSourceHash omits the computed source_id so that
+// configured mapping sources match those returned by the API.
+func auditManagerControlMappingSourceHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	for _, k := range []string{"source_description", "source_frequency", "source_name", "source_set_up_option", "source_type", "troubleshooting_text"} {
+		v, _ := m[k].(string)
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+	if v, ok := m["source_keyword"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		keyword := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("%v-%v-", keyword["keyword_input_type"], keyword["keyword_value"]))
+	}
+	return hashcode.String(buf.String())
+}
+
+func expandAuditManagerSourceKeyword(tfMap map[string]interface{}) *auditmanager.SourceKeyword {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.SourceKeyword{}
+
+	if v, ok := tfMap["keyword_input_type"].(string); ok && v != "" {
+		apiObject.KeywordInputType = aws.String(v)
+	}
+
+	if v, ok := tfMap["keyword_value"].(string); ok && v != "" {
+		apiObject.KeywordValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAuditManagerCreateControlMappingSources(tfList []interface{}) []*auditmanager.CreateControlMappingSource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.CreateControlMappingSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.CreateControlMappingSource{}
+
+		if v, ok := tfMap["source_description"].(string); ok && v != "" {
+			apiObject.SourceDescription = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_frequency"].(string); ok && v != "" {
+			apiObject.SourceFrequency = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_keyword"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceKeyword = expandAuditManagerSourceKeyword(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_name"].(string); ok && v != "" {
+			apiObject.SourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_set_up_option"].(string); ok && v != "" {
+			apiObject.SourceSetUpOption = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_type"].(string); ok && v != "" {
+			apiObject.SourceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["troubleshooting_text"].(string); ok && v != "" {
+			apiObject.TroubleshootingText = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAuditManagerControlMappingSources(tfList []interface{}) []*auditmanager.ControlMappingSource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.ControlMappingSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.ControlMappingSource{}
+
+		if v, ok := tfMap["source_description"].(string); ok && v != "" {
+			apiObject.SourceDescription = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_frequency"].(string); ok && v != "" {
+			apiObject.SourceFrequency = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_id"].(string); ok && v != "" {
+			apiObject.SourceId = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_keyword"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceKeyword = expandAuditManagerSourceKeyword(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_name"].(string); ok && v != "" {
+			apiObject.SourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_set_up_option"].(string); ok && v != "" {
+			apiObject.SourceSetUpOption = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_type"].(string); ok && v != "" {
+			apiObject.SourceType = aws.String(v)
+		}
+
+		if v, ok := tfMap["troubleshooting_text"].(string); ok && v != "" {
+			apiObject.TroubleshootingText = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAuditManagerSourceKeyword(apiObject *auditmanager.SourceKeyword) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KeywordInputType; v != nil {
+		tfMap["keyword_input_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeywordValue; v != nil {
+		tfMap["keyword_value"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAuditManagerControlMappingSources(apiObjects []*auditmanager.ControlMappingSource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.SourceDescription; v != nil {
+			tfMap["source_description"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.SourceFrequency; v != nil {
+			tfMap["source_frequency"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.SourceId; v != nil {
+			tfMap["source_id"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.SourceKeyword; v != nil {
+			tfMap["source_keyword"] = []interface{}{flattenAuditManagerSourceKeyword(v)}
+		}
+
+		if v := apiObject.SourceName; v != nil {
+			tfMap["source_name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.SourceSetUpOption; v != nil {
+			tfMap["source_set_up_option"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.SourceType; v != nil {
+			tfMap["source_type"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.TroubleshootingText; v != nil {
+			tfMap["troubleshooting_text"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAuditManagerControl_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_control.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerControlExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "auditmanager", regexp.MustCompile(`control/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "control_mapping_sources.*", map[string]string{
+						"source_name":          rName,
+						"source_set_up_option": auditmanager.SourceSetUpOptionProceduralControlsMapping,
+						"source_type":          auditmanager.SourceTypeManual,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", auditmanager.ControlTypeCustom),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAuditManagerControl_disappears(t *testing.T) {
+	resourceName := "aws_auditmanager_control.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerControlExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAuditManagerControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAuditManagerControl_update(t *testing.T) {
+	resourceName := "aws_auditmanager_control.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerControlConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerControlExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAuditManagerControlConfigUpdated(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerControlExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_plan_title", "title"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "control_mapping_sources.*", map[string]string{
+						"source_keyword.#":                    "1",
+						"source_keyword.0.keyword_value":      "IAM_PASSWORD_POLICY",
+						"source_keyword.0.keyword_input_type": auditmanager.KeywordInputTypeSelectFromList,
+						"source_name":                         rName + "-config",
+						"source_set_up_option":                auditmanager.SourceSetUpOptionSystemControlsMapping,
+						"source_type":                         auditmanager.SourceTypeAwsConfig,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAuditManagerControlDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_control" {
+			continue
+		}
+
+		_, err := finder.ControlByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAuditManagerControlExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Control ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+		_, err := finder.ControlByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSAuditManager(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+	output, err := conn.GetAccountStatus(&auditmanager.GetAccountStatusInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if status := aws.StringValue(output.Status); status != auditmanager.AccountStatusActive {
+		t.Skipf("skipping acceptance testing: Audit Manager account status is %s", status)
+	}
+}
+
+func testAccAuditManagerControlConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+}
+`, rName)
+}
+
+func testAccAuditManagerControlConfigUpdated(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name                     = %[1]q
+  description              = "updated"
+  action_plan_title        = "title"
+  action_plan_instructions = "instructions"
+  testing_information      = "testing"
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+    troubleshooting_text = "troubleshooting"
+  }
+
+  control_mapping_sources {
+    source_name          = "%[1]s-config"
+    source_set_up_option = "System_Controls_Mapping"
+    source_type          = "AWS_Config"
+
+    source_keyword {
+      keyword_input_type = "SELECT_FROM_LIST"
+      keyword_value      = "IAM_PASSWORD_POLICY"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/auditmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAuditManagerFramework_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_framework.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerFrameworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerFrameworkConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerFrameworkExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "auditmanager", regexp.MustCompile(`assessmentFramework/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "control_sets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "control_sets.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "control_sets.0.controls.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "control_sets.0.controls.*.id", "aws_auditmanager_control.test.0", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "control_sets.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", auditmanager.FrameworkTypeCustom),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAuditManagerFramework_disappears(t *testing.T) {
+	resourceName := "aws_auditmanager_framework.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerFrameworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerFrameworkConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerFrameworkExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAuditManagerFramework(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAuditManagerFramework_update(t *testing.T) {
+	resourceName := "aws_auditmanager_framework.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(auditmanager.EndpointsID, t)
+			testAccPreCheckAWSAuditManager(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAuditManagerFrameworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditManagerFrameworkConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerFrameworkExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAuditManagerFrameworkConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAuditManagerFrameworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compliance_type", "custom"),
+					resource.TestCheckResourceAttr(resourceName, "control_sets.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "control_sets.0.controls.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "control_sets.1.name", rName+"-2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAuditManagerFrameworkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_framework" {
+			continue
+		}
+
+		_, err := finder.FrameworkByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Framework %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAuditManagerFrameworkExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Framework ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).auditmanagerconn
+
+		_, err := finder.FrameworkByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAuditManagerFrameworkConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+}
+`, rName)
+}
+
+func testAccAuditManagerFrameworkConfig(rName string) string {
+	return composeConfig(testAccAuditManagerFrameworkConfigBase(rName), fmt.Sprintf(`
+resource "aws_auditmanager_framework" "test" {
+  name = %[1]q
+
+  control_sets {
+    name = %[1]q
+
+    controls {
+      id = aws_auditmanager_control.test[0].id
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAuditManagerFrameworkConfigUpdated(rName string) string {
+	return composeConfig(testAccAuditManagerFrameworkConfigBase(rName), fmt.Sprintf(`
+resource "aws_auditmanager_framework" "test" {
+  name            = %[1]q
+  description     = "updated"
+  compliance_type = "custom"
+
+  control_sets {
+    name = %[1]q
+
+    controls {
+      id = aws_auditmanager_control.test[0].id
+    }
+
+    controls {
+      id = aws_auditmanager_control.test[1].id
+    }
+  }
+
+  control_sets {
+    name = "%[1]s-2"
+
+    controls {
+      id = aws_auditmanager_control.test[1].id
+    }
+  }
+}
+`, rName))
+}
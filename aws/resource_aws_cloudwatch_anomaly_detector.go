@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfcloudwatch "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudWatchAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchAnomalyDetectorCreate,
+		Read:   resourceAwsCloudWatchAnomalyDetectorRead,
+		Update: resourceAwsCloudWatchAnomalyDetectorUpdate,
+		Delete: resourceAwsCloudWatchAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudWatchAnomalyDetectorImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_time_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+						"metric_timezone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 50),
+						},
+					},
+				},
+			},
+			"dimensions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^:]{1,255}$`), "must be between 1 and 255 characters and must not contain colon characters"),
+			},
+			"stat": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchAnomalyDetectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		Dimensions: expandCloudWatchAnomalyDetectorDimensions(d),
+		MetricName: aws.String(d.Get("metric_name").(string)),
+		Namespace:  aws.String(d.Get("namespace").(string)),
+		Stat:       aws.String(d.Get("stat").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandCloudWatchAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Anomaly Detector: %s", input)
+	_, err := conn.PutAnomalyDetector(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Anomaly Detector: %w", err)
+	}
+
+	// Anomaly detectors have no identifier and are found by their definition.
+	dimensions := aws.StringValueMap(expandStringMap(d.Get("dimensions").(map[string]interface{})))
+	d.SetId(tfcloudwatch.AnomalyDetectorCreateResourceID(d.Get("namespace").(string), d.Get("metric_name").(string), d.Get("stat").(string), dimensions))
+
+	return resourceAwsCloudWatchAnomalyDetectorRead(d, meta)
+}
+
+func resourceAwsCloudWatchAnomalyDetectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	detector, err := finder.AnomalyDetector(conn, d.Get("namespace").(string), d.Get("metric_name").(string), d.Get("stat").(string), expandCloudWatchAnomalyDetectorDimensions(d))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Anomaly Detector (%s): %w", d.Id(), err)
+	}
+
+	if v := detector.Configuration; v != nil && (len(v.ExcludedTimeRanges) > 0 || aws.StringValue(v.MetricTimezone) != "") {
+		if err := d.Set("configuration", []interface{}{flattenCloudWatchAnomalyDetectorConfiguration(detector.Configuration)}); err != nil {
+			return fmt.Errorf("error setting configuration: %w", err)
+		}
+	} else {
+		d.Set("configuration", nil)
+	}
+	d.Set("dimensions", flattenAwsCloudWatchMetricAlarmDimensions(detector.Dimensions))
+	d.Set("metric_name", detector.MetricName)
+	d.Set("namespace", detector.Namespace)
+	d.Set("stat", detector.Stat)
+	d.Set("state_value", detector.StateValue)
+
+	return nil
+}
+
+func resourceAwsCloudWatchAnomalyDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		Configuration: &cloudwatch.AnomalyDetectorConfiguration{},
+		Dimensions:    expandCloudWatchAnomalyDetectorDimensions(d),
+		MetricName:    aws.String(d.Get("metric_name").(string)),
+		Namespace:     aws.String(d.Get("namespace").(string)),
+		Stat:          aws.String(d.Get("stat").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandCloudWatchAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Anomaly Detector: %s", input)
+	_, err := conn.PutAnomalyDetector(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudWatch Anomaly Detector (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchAnomalyDetectorRead(d, meta)
+}
+
+func resourceAwsCloudWatchAnomalyDetectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteAnomalyDetector(&cloudwatch.DeleteAnomalyDetectorInput{
+		Dimensions: expandCloudWatchAnomalyDetectorDimensions(d),
+		MetricName: aws.String(d.Get("metric_name").(string)),
+		Namespace:  aws.String(d.Get("namespace").(string)),
+		Stat:       aws.String(d.Get("stat").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Anomaly Detector (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchAnomalyDetectorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	namespace, metricName, stat, dimensions, err := tfcloudwatch.AnomalyDetectorParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("dimensions", dimensions)
+	d.Set("metric_name", metricName)
+	d.Set("namespace", namespace)
+	d.Set("stat", stat)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandCloudWatchAnomalyDetectorDimensions returns the dimensions that, with the metric and statistic, identify the resource.
+func expandCloudWatchAnomalyDetectorDimensions(d *schema.ResourceData) []*cloudwatch.Dimension {
+	if v, ok := d.GetOk("dimensions"); ok && len(v.(map[string]interface{})) > 0 {
+		return expandAwsCloudWatchMetricAlarmDimensions(v.(map[string]interface{}))
+	}
+
+	return nil
+}
+
+func expandCloudWatchAnomalyDetectorConfiguration(tfMap map[string]interface{}) *cloudwatch.AnomalyDetectorConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatch.AnomalyDetectorConfiguration{}
+
+	if v, ok := tfMap["excluded_time_range"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			timeRange, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			// Values are validated as RFC3339 at plan time.
+			startTime, _ := time.Parse(time.RFC3339, timeRange["start_time"].(string))
+			endTime, _ := time.Parse(time.RFC3339, timeRange["end_time"].(string))
+
+			apiObject.ExcludedTimeRanges = append(apiObject.ExcludedTimeRanges, &cloudwatch.Range{
+				EndTime:   aws.Time(endTime),
+				StartTime: aws.Time(startTime),
+			})
+		}
+	}
+
+	if v, ok := tfMap["metric_timezone"].(string); ok && v != "" {
+		apiObject.MetricTimezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudWatchAnomalyDetectorConfiguration(apiObject *cloudwatch.AnomalyDetectorConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ExcludedTimeRanges; v != nil {
+		var tfList []interface{}
+
+		for _, timeRange := range v {
+			if timeRange == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"end_time":   aws.TimeValue(timeRange.EndTime).Format(time.RFC3339),
+				"start_time": aws.TimeValue(timeRange.StartTime).Format(time.RFC3339),
+			})
+		}
+
+		tfMap["excluded_time_range"] = tfList
+	}
+
+	if v := apiObject.MetricTimezone; v != nil {
+		tfMap["metric_timezone"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
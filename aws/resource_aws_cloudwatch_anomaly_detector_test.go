@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCloudWatchAnomalyDetector_basic(t *testing.T) {
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchAnomalyDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchAnomalyDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("AWS/Lambda:Invocations:Sum:FunctionName=%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.FunctionName", rName),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "Invocations"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "AWS/Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "stat", "Sum"),
+					resource.TestCheckResourceAttrSet(resourceName, "state_value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchAnomalyDetector_disappears(t *testing.T) {
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchAnomalyDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchAnomalyDetectorExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchAnomalyDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchAnomalyDetector_configuration(t *testing.T) {
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchAnomalyDetectorConfigConfiguration(rName, "2021-01-01T00:00:00Z", "2021-01-02T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchAnomalyDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2021-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "UTC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchAnomalyDetectorConfigConfiguration(rName, "2021-02-01T00:00:00Z", "2021-02-03T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchAnomalyDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2021-02-03T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2021-02-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchAnomalyDetectorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_anomaly_detector" {
+			continue
+		}
+
+		_, err := testAccAWSCloudWatchAnomalyDetectorFind(conn, rs)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Anomaly Detector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudWatchAnomalyDetectorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Anomaly Detector ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		_, err := testAccAWSCloudWatchAnomalyDetectorFind(conn, rs)
+
+		return err
+	}
+}
+
+// testAccAWSCloudWatchAnomalyDetectorFind looks up the detector from its definition in state,
+// as anomaly detectors can only be looked up by their definition.
+func testAccAWSCloudWatchAnomalyDetectorFind(conn *cloudwatch.CloudWatch, rs *terraform.ResourceState) (*cloudwatch.AnomalyDetector, error) {
+	d := resourceAwsCloudWatchAnomalyDetector().Data(rs.Primary)
+
+	return finder.AnomalyDetector(conn, d.Get("namespace").(string), d.Get("metric_name").(string), d.Get("stat").(string), expandCloudWatchAnomalyDetectorDimensions(d))
+}
+
+func testAccAWSCloudWatchAnomalyDetectorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  namespace   = "AWS/Lambda"
+  metric_name = "Invocations"
+  stat        = "Sum"
+
+  dimensions = {
+    FunctionName = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchAnomalyDetectorConfigConfiguration(rName, startTime, endTime string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  namespace   = "AWS/Lambda"
+  metric_name = "Invocations"
+  stat        = "Sum"
+
+  dimensions = {
+    FunctionName = %[1]q
+  }
+
+  configuration {
+    metric_timezone = "UTC"
+
+    excluded_time_range {
+      start_time = %[2]q
+      end_time   = %[3]q
+    }
+  }
+}
+`, rName, startTime, endTime)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfcodestarnotifications "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarnotifications"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
@@ -94,9 +95,10 @@ func resourceAwsCodeStarNotificationsNotificationRule() *schema.Resource {
 							ValidateFunc: validateArn,
 						},
 						"type": {
-							Type:     schema.TypeString,
-							Default:  "SNS",
-							Optional: true,
+							Type:         schema.TypeString,
+							Default:      tfcodestarnotifications.TargetTypeSNS,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tfcodestarnotifications.TargetType_Values(), false),
 						},
 						"status": {
 							Type:     schema.TypeString,
@@ -23,6 +23,12 @@ func resourceAwsConfigOrganizationConformancePack() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ConfigOrganizationConformancePackCreateTimeout),
+			Delete: schema.DefaultTimeout(ConfigOrganizationConformancePackDeleteTimeout),
+			Update: schema.DefaultTimeout(ConfigOrganizationConformancePackUpdateTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -141,7 +147,7 @@ func resourceAwsConfigOrganizationConformancePackCreate(d *schema.ResourceData,
 
 	d.SetId(name)
 
-	if err := configWaitForOrganizationConformancePackStatusCreateSuccessful(conn, d.Id()); err != nil {
+	if err := configWaitForOrganizationConformancePackStatusCreateSuccessful(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for Config Organization Conformance Pack (%s) to be created: %w", d.Id(), err)
 	}
 
@@ -226,7 +232,7 @@ func resourceAwsConfigOrganizationConformancePackUpdate(d *schema.ResourceData,
 		return fmt.Errorf("error updating Config Organization Conformance Pack (%s): %w", d.Id(), err)
 	}
 
-	if err := configWaitForOrganizationConformancePackStatusUpdateSuccessful(conn, d.Id()); err != nil {
+	if err := configWaitForOrganizationConformancePackStatusUpdateSuccessful(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("error waiting for Config Organization Conformance Pack (%s) to be updated: %w", d.Id(), err)
 	}
 
@@ -250,7 +256,7 @@ func resourceAwsConfigOrganizationConformancePackDelete(d *schema.ResourceData,
 		return fmt.Errorf("erorr deleting Config Organization Conformance Pack (%s): %w", d.Id(), err)
 	}
 
-	if err := configWaitForOrganizationConformancePackStatusDeleteSuccessful(conn, d.Id()); err != nil {
+	if err := configWaitForOrganizationConformancePackStatusDeleteSuccessful(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConformancePackException) {
 			return nil
 		}
@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCustomerProfilesDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCustomerProfilesDomainCreate,
+		Read:   resourceAwsCustomerProfilesDomainRead,
+		Update: resourceAwsCustomerProfilesDomainUpdate,
+		Delete: resourceAwsCustomerProfilesDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dead_letter_queue_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"default_encryption_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"default_expiration_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1098),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"matching": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsCustomerProfilesDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("domain_name").(string)
+	input := &customerprofiles.CreateDomainInput{
+		DefaultExpirationDays: aws.Int64(int64(d.Get("default_expiration_days").(int))),
+		DomainName:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("dead_letter_queue_url"); ok {
+		input.DeadLetterQueueUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_encryption_key"); ok {
+		input.DefaultEncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("matching"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Matching = &customerprofiles.MatchingRequest{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().CustomerprofilesTags()
+	}
+
+	log.Printf("[DEBUG] Creating Customer Profiles Domain: %s", input)
+	_, err := conn.CreateDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Customer Profiles Domain (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCustomerProfilesDomainRead(d, meta)
+}
+
+func resourceAwsCustomerProfilesDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.DomainByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Customer Profiles Domain (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "profile",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("domains/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("dead_letter_queue_url", output.DeadLetterQueueUrl)
+	d.Set("default_encryption_key", output.DefaultEncryptionKey)
+	d.Set("default_expiration_days", output.DefaultExpirationDays)
+	d.Set("domain_name", output.DomainName)
+	if output.Matching != nil {
+		if err := d.Set("matching", []interface{}{map[string]interface{}{"enabled": aws.BoolValue(output.Matching.Enabled)}}); err != nil {
+			return fmt.Errorf("error setting matching: %w", err)
+		}
+	} else {
+		d.Set("matching", nil)
+	}
+
+	tags := keyvaluetags.CustomerprofilesKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCustomerProfilesDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// Empty strings clear the existing values.
+		input := &customerprofiles.UpdateDomainInput{
+			DeadLetterQueueUrl:    aws.String(d.Get("dead_letter_queue_url").(string)),
+			DefaultEncryptionKey:  aws.String(d.Get("default_encryption_key").(string)),
+			DefaultExpirationDays: aws.Int64(int64(d.Get("default_expiration_days").(int))),
+			DomainName:            aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("matching"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Matching = &customerprofiles.MatchingRequest{
+				Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+			}
+		} else {
+			input.Matching = &customerprofiles.MatchingRequest{
+				Enabled: aws.Bool(false),
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Customer Profiles Domain: %s", input)
+		_, err := conn.UpdateDomain(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Customer Profiles Domain (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.CustomerprofilesUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Customer Profiles Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCustomerProfilesDomainRead(d, meta)
+}
+
+func resourceAwsCustomerProfilesDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	log.Printf("[DEBUG] Deleting Customer Profiles Domain: %s", d.Id())
+	_, err := conn.DeleteDomain(&customerprofiles.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Customer Profiles Domain (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCustomerProfilesDomain_basic(t *testing.T) {
+	var v customerprofiles.GetDomainOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesDomainConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesDomainExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "profile", regexp.MustCompile(`domains/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_queue_url", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_encryption_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_expiration_days", "365"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "matching.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCustomerProfilesDomain_disappears(t *testing.T) {
+	var v customerprofiles.GetDomainOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesDomainConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesDomainExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCustomerProfilesDomain(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCustomerProfilesDomain_update(t *testing.T) {
+	var v customerprofiles.GetDomainOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesDomainConfigUpdate(rName, 365, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_expiration_days", "365"),
+					resource.TestCheckResourceAttr(resourceName, "matching.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCustomerProfilesDomainConfigUpdate(rName, 180, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_expiration_days", "180"),
+					resource.TestCheckResourceAttr(resourceName, "matching.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCustomerProfilesDomain_tags(t *testing.T) {
+	var v customerprofiles.GetDomainOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesDomainConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCustomerProfilesDomainConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSCustomerProfilesDomainConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesDomainExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCustomerProfilesDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).customerprofilesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_customerprofiles_domain" {
+			continue
+		}
+
+		_, err := finder.DomainByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Customer Profiles Domain %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCustomerProfilesDomainExists(n string, v *customerprofiles.GetDomainOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Customer Profiles Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).customerprofilesconn
+
+		output, err := finder.DomainByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCustomerProfilesDomainConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+`, rName)
+}
+
+func testAccAWSCustomerProfilesDomainConfigUpdate(rName string, expirationDays int, matchingEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = %[2]d
+
+  matching {
+    enabled = %[3]t
+  }
+}
+`, rName, expirationDays, matchingEnabled)
+}
+
+func testAccAWSCustomerProfilesDomainConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSCustomerProfilesDomainConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
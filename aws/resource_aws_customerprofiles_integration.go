@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfcustomerprofiles "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCustomerProfilesIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCustomerProfilesIntegrationPut,
+		Read:   resourceAwsCustomerProfilesIntegrationRead,
+		Update: resourceAwsCustomerProfilesIntegrationPut,
+		Delete: resourceAwsCustomerProfilesIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"object_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceAwsCustomerProfilesIntegrationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	domainName := d.Get("domain_name").(string)
+	uri := d.Get("uri").(string)
+	id := tfcustomerprofiles.IntegrationCreateResourceID(domainName, uri)
+	input := &customerprofiles.PutIntegrationInput{
+		DomainName:     aws.String(domainName),
+		ObjectTypeName: aws.String(d.Get("object_type_name").(string)),
+		Uri:            aws.String(uri),
+	}
+
+	log.Printf("[DEBUG] Putting Customer Profiles Integration: %s", input)
+	_, err := conn.PutIntegration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Customer Profiles Integration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsCustomerProfilesIntegrationRead(d, meta)
+}
+
+func resourceAwsCustomerProfilesIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	domainName, uri, err := tfcustomerprofiles.IntegrationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.IntegrationByDomainNameAndURI(conn, domainName, uri)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Customer Profiles Integration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("domain_name", output.DomainName)
+	d.Set("object_type_name", output.ObjectTypeName)
+	d.Set("uri", output.Uri)
+
+	return nil
+}
+
+func resourceAwsCustomerProfilesIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	domainName, uri, err := tfcustomerprofiles.IntegrationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Customer Profiles Integration: %s", d.Id())
+	_, err = conn.DeleteIntegration(&customerprofiles.DeleteIntegrationInput{
+		DomainName: aws.String(domainName),
+		Uri:        aws.String(uri),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Customer Profiles Integration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
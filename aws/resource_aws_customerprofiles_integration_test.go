@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfcustomerprofiles "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCustomerProfilesIntegration_basic(t *testing.T) {
+	var v customerprofiles.GetIntegrationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_customerprofiles_integration.test"
+	instanceResourceName := "aws_connect_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesIntegrationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesIntegrationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "object_type_name", "CTR"),
+					resource.TestCheckResourceAttrPair(resourceName, "uri", instanceResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCustomerProfilesIntegration_disappears(t *testing.T) {
+	var v customerprofiles.GetIntegrationOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_customerprofiles_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesIntegrationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesIntegrationExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCustomerProfilesIntegration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCustomerProfilesIntegrationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).customerprofilesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_customerprofiles_integration" {
+			continue
+		}
+
+		domainName, uri, err := tfcustomerprofiles.IntegrationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.IntegrationByDomainNameAndURI(conn, domainName, uri)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Customer Profiles Integration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCustomerProfilesIntegrationExists(n string, v *customerprofiles.GetIntegrationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Customer Profiles Integration ID is set")
+		}
+
+		domainName, uri, err := tfcustomerprofiles.IntegrationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).customerprofilesconn
+
+		output, err := finder.IntegrationByDomainNameAndURI(conn, domainName, uri)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCustomerProfilesIntegrationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = "CTR"
+  description      = "Amazon Connect contact trace records"
+  template_id      = "CTR-NoInferred"
+}
+
+resource "aws_customerprofiles_integration" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = aws_customerprofiles_profile_object_type.test.object_type_name
+  uri              = aws_connect_instance.test.arn
+}
+`, rName)
+}
@@ -0,0 +1,380 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfcustomerprofiles "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCustomerProfilesProfileObjectType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCustomerProfilesProfileObjectTypePut,
+		Read:   resourceAwsCustomerProfilesProfileObjectTypeRead,
+		Update: resourceAwsCustomerProfilesProfileObjectTypeUpdate,
+		Delete: resourceAwsCustomerProfilesProfileObjectTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allow_profile_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"encryption_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"expiration_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1098),
+			},
+			"field": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      customerprofiles.FieldContentTypeString,
+							ValidateFunc: validation.StringInSlice(customerprofiles.FieldContentType_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+					},
+				},
+			},
+			"key": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"standard_identifiers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(customerprofiles.StandardIdentifier_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"object_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_-]*$`), "must start with a letter or underscore and contain only letters, hyphens and underscores"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+// resourceAwsCustomerProfilesProfileObjectTypePut handles both create and update, as PutProfileObjectType is an upsert.
+func resourceAwsCustomerProfilesProfileObjectTypePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	domainName := d.Get("domain_name").(string)
+	objectTypeName := d.Get("object_type_name").(string)
+	id := tfcustomerprofiles.ProfileObjectTypeCreateResourceID(domainName, objectTypeName)
+	input := &customerprofiles.PutProfileObjectTypeInput{
+		AllowProfileCreation: aws.Bool(d.Get("allow_profile_creation").(bool)),
+		Description:          aws.String(d.Get("description").(string)),
+		DomainName:           aws.String(domainName),
+		ObjectTypeName:       aws.String(objectTypeName),
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expiration_days"); ok {
+		input.ExpirationDays = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("field"); ok && v.(*schema.Set).Len() > 0 {
+		input.Fields = expandCustomerProfilesObjectTypeFields(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("key"); ok && v.(*schema.Set).Len() > 0 {
+		input.Keys = expandCustomerProfilesObjectTypeKeys(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("template_id"); ok {
+		input.TemplateId = aws.String(v.(string))
+	}
+
+	if d.IsNewResource() {
+		defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+		tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+		if len(tags) > 0 {
+			input.Tags = tags.IgnoreAws().CustomerprofilesTags()
+		}
+	}
+
+	log.Printf("[DEBUG] Putting Customer Profiles Profile Object Type: %s", input)
+	_, err := conn.PutProfileObjectType(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Customer Profiles Profile Object Type (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsCustomerProfilesProfileObjectTypeRead(d, meta)
+}
+
+func resourceAwsCustomerProfilesProfileObjectTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	domainName, objectTypeName, err := tfcustomerprofiles.ProfileObjectTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.ProfileObjectTypeByDomainNameAndName(conn, domainName, objectTypeName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Profile Object Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Customer Profiles Profile Object Type (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "profile",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("domains/%s/object-types/%s", domainName, objectTypeName),
+	}.String()
+	d.Set("allow_profile_creation", output.AllowProfileCreation)
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	d.Set("domain_name", domainName)
+	d.Set("encryption_key", output.EncryptionKey)
+	d.Set("expiration_days", output.ExpirationDays)
+	if err := d.Set("field", flattenCustomerProfilesObjectTypeFields(output.Fields)); err != nil {
+		return fmt.Errorf("error setting field: %w", err)
+	}
+	if err := d.Set("key", flattenCustomerProfilesObjectTypeKeys(output.Keys)); err != nil {
+		return fmt.Errorf("error setting key: %w", err)
+	}
+	d.Set("object_type_name", output.ObjectTypeName)
+	d.Set("template_id", output.TemplateId)
+
+	tags := keyvaluetags.CustomerprofilesKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCustomerProfilesProfileObjectTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		if err := resourceAwsCustomerProfilesProfileObjectTypePut(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.CustomerprofilesUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Customer Profiles Profile Object Type (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCustomerProfilesProfileObjectTypeRead(d, meta)
+}
+
+func resourceAwsCustomerProfilesProfileObjectTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).customerprofilesconn
+
+	domainName, objectTypeName, err := tfcustomerprofiles.ProfileObjectTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Customer Profiles Profile Object Type: %s", d.Id())
+	_, err = conn.DeleteProfileObjectType(&customerprofiles.DeleteProfileObjectTypeInput{
+		DomainName:     aws.String(domainName),
+		ObjectTypeName: aws.String(objectTypeName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Customer Profiles Profile Object Type (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCustomerProfilesObjectTypeFields(tfList []interface{}) map[string]*customerprofiles.ObjectTypeField {
+	apiObjects := map[string]*customerprofiles.ObjectTypeField{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["name"].(string)] = &customerprofiles.ObjectTypeField{
+			ContentType: aws.String(tfMap["content_type"].(string)),
+			Source:      aws.String(tfMap["source"].(string)),
+			Target:      aws.String(tfMap["target"].(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenCustomerProfilesObjectTypeFields(apiObjects map[string]*customerprofiles.ObjectTypeField) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"content_type": aws.StringValue(apiObject.ContentType),
+			"name":         name,
+			"source":       aws.StringValue(apiObject.Source),
+			"target":       aws.StringValue(apiObject.Target),
+		})
+	}
+
+	return tfList
+}
+
+func expandCustomerProfilesObjectTypeKeys(tfList []interface{}) map[string][]*customerprofiles.ObjectTypeKey {
+	apiObjects := map[string][]*customerprofiles.ObjectTypeKey{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &customerprofiles.ObjectTypeKey{}
+
+		if v, ok := tfMap["field_names"].([]interface{}); ok && len(v) > 0 {
+			apiObject.FieldNames = expandStringList(v)
+		}
+
+		if v, ok := tfMap["standard_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.StandardIdentifiers = expandStringSet(v)
+		}
+
+		name := tfMap["name"].(string)
+		apiObjects[name] = append(apiObjects[name], apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCustomerProfilesObjectTypeKeys(apiObjects map[string][]*customerprofiles.ObjectTypeKey) []interface{} {
+	var tfList []interface{}
+
+	for name, keys := range apiObjects {
+		for _, apiObject := range keys {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"field_names":          aws.StringValueSlice(apiObject.FieldNames),
+				"name":                 name,
+				"standard_identifiers": aws.StringValueSlice(apiObject.StandardIdentifiers),
+			})
+		}
+	}
+
+	return tfList
+}
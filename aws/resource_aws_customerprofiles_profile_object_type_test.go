@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfcustomerprofiles "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/customerprofiles/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCustomerProfilesProfileObjectType_basic(t *testing.T) {
+	var v customerprofiles.GetProfileObjectTypeOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	objectTypeName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+	domainResourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesProfileObjectTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesProfileObjectTypeConfig(rName, objectTypeName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesProfileObjectTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_profile_creation", "true"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "profile", regexp.MustCompile(`domains/.+/object-types/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", domainResourceName, "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "key.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "object_type_name", objectTypeName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCustomerProfilesProfileObjectTypeConfig(rName, objectTypeName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesProfileObjectTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCustomerProfilesProfileObjectType_disappears(t *testing.T) {
+	var v customerprofiles.GetProfileObjectTypeOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	objectTypeName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(customerprofiles.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, customerprofiles.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCustomerProfilesProfileObjectTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCustomerProfilesProfileObjectTypeConfig(rName, objectTypeName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCustomerProfilesProfileObjectTypeExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCustomerProfilesProfileObjectType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCustomerProfilesProfileObjectTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).customerprofilesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_customerprofiles_profile_object_type" {
+			continue
+		}
+
+		domainName, objectTypeName, err := tfcustomerprofiles.ProfileObjectTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ProfileObjectTypeByDomainNameAndName(conn, domainName, objectTypeName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Customer Profiles Profile Object Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCustomerProfilesProfileObjectTypeExists(n string, v *customerprofiles.GetProfileObjectTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Customer Profiles Profile Object Type ID is set")
+		}
+
+		domainName, objectTypeName, err := tfcustomerprofiles.ProfileObjectTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).customerprofilesconn
+
+		output, err := finder.ProfileObjectTypeByDomainNameAndName(conn, domainName, objectTypeName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCustomerProfilesProfileObjectTypeConfig(rName, objectTypeName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name            = aws_customerprofiles_domain.test.domain_name
+  object_type_name       = %[2]q
+  description            = %[3]q
+  allow_profile_creation = true
+
+  field {
+    name         = "email"
+    source       = "_source.email"
+    target       = "_profile.EmailAddress"
+    content_type = "EMAIL_ADDRESS"
+  }
+
+  field {
+    name   = "id"
+    source = "_source.id"
+    target = "_profile.AccountNumber"
+  }
+
+  key {
+    name                 = "id"
+    field_names          = ["id"]
+    standard_identifiers = ["PROFILE", "UNIQUE"]
+  }
+}
+`, rName, objectTypeName, description)
+}
@@ -38,6 +38,13 @@ func resourceAwsDaxCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cluster_endpoint_encryption_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dax.ClusterEndpointEncryptionTypeNone,
+				ValidateFunc: validation.StringInSlice(dax.ClusterEndpointEncryptionType_Values(), false),
+			},
 			"cluster_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -190,13 +197,14 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIds := expandStringSet(securityIdSet)
 
 	req := &dax.CreateClusterInput{
-		ClusterName:       aws.String(clusterName),
-		IamRoleArn:        aws.String(iamRoleArn),
-		NodeType:          aws.String(nodeType),
-		ReplicationFactor: aws.Int64(numNodes),
-		SecurityGroupIds:  securityIds,
-		SubnetGroupName:   aws.String(subnetGroupName),
-		Tags:              tags.IgnoreAws().DaxTags(),
+		ClusterEndpointEncryptionType: aws.String(d.Get("cluster_endpoint_encryption_type").(string)),
+		ClusterName:                   aws.String(clusterName),
+		IamRoleArn:                    aws.String(iamRoleArn),
+		NodeType:                      aws.String(nodeType),
+		ReplicationFactor:             aws.Int64(numNodes),
+		SecurityGroupIds:              securityIds,
+		SubnetGroupName:               aws.String(subnetGroupName),
+		Tags:                          tags.IgnoreAws().DaxTags(),
 	}
 
 	// optionals can be defaulted by AWS
@@ -301,6 +309,7 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	c := res.Clusters[0]
 	d.Set("arn", c.ClusterArn)
+	d.Set("cluster_endpoint_encryption_type", c.ClusterEndpointEncryptionType)
 	d.Set("cluster_name", c.ClusterName)
 	d.Set("description", c.Description)
 	d.Set("iam_role_arn", c.IamRoleArn)
@@ -102,6 +102,8 @@ func TestAccAWSDAXCluster_basic(t *testing.T) {
 						resourceName, "server_side_encryption.#", "1"),
 					resource.TestCheckResourceAttr(
 						resourceName, "server_side_encryption.0.enabled", "false"),
+					resource.TestCheckResourceAttr(
+						resourceName, "cluster_endpoint_encryption_type", "NONE"),
 				),
 			},
 			{
@@ -191,6 +193,39 @@ func TestAccAWSDAXCluster_encryption_disabled(t *testing.T) {
 	})
 }
 
+func TestAccAWSDAXCluster_EndpointEncryption_enabled(t *testing.T) {
+	var dc dax.Cluster
+	rString := acctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDax(t) },
+		ErrorCheck:   testAccErrorCheck(t, dax.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDAXClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDAXClusterConfigWithEndpointEncryption(rString, dax.ClusterEndpointEncryptionTypeTls),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDAXClusterExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", dax.ClusterEndpointEncryptionTypeTls),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Ensure it shows a difference when removing cluster_endpoint_encryption_type configuration
+			{
+				Config:             testAccAWSDAXClusterConfig(rString),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSDAXCluster_encryption_enabled(t *testing.T) {
 	var dc dax.Cluster
 	rString := acctest.RandString(10)
@@ -366,6 +401,23 @@ resource "aws_dax_cluster" "test" {
 `, baseConfig, rString, enabled)
 }
 
+func testAccAWSDAXClusterConfigWithEndpointEncryption(rString string, encryptionType string) string {
+	return fmt.Sprintf(`%s
+resource "aws_dax_cluster" "test" {
+  cluster_name                     = "tf-%s"
+  iam_role_arn                     = aws_iam_role.test.arn
+  node_type                        = "dax.t2.small"
+  replication_factor               = 1
+  description                      = "test cluster"
+  cluster_endpoint_encryption_type = %q
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, baseConfig, rString, encryptionType)
+}
+
 func testAccAWSDAXClusterConfigResize_singleNode(rString string) string {
 	return fmt.Sprintf(`%s
 resource "aws_dax_cluster" "test" {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
 )
 
 func resourceAwsDbInstance() *schema.Resource {
@@ -1772,10 +1773,19 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Waiting for DB Instance (%s) to be available", d.Id())
+		start := time.Now()
 		err = waitUntilAwsDbInstanceIsAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
 		}
+
+		// Multi-AZ conversions can keep running after the instance first reports available.
+		// Both waits share the update timeout.
+		if d.HasChange("multi_az") && aws.BoolValue(req.ApplyImmediately) {
+			if _, err := waiter.DBInstanceMultiAZUpdated(conn, d.Id(), d.Get("multi_az").(bool), d.Timeout(schema.TimeoutUpdate)-time.Since(start)); err != nil {
+				return fmt.Errorf("error waiting for DB Instance (%s) Multi-AZ conversion: %w", d.Id(), err)
+			}
+		}
 	}
 
 	// separate request to promote a database
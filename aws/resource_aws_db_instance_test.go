@@ -469,6 +469,37 @@ func TestAccAWSDBInstance_DeletionProtection(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBInstance_MultiAZ(t *testing.T) {
+	var dbInstance rds.DBInstance
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBInstanceConfig_MultiAZ(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "false"),
+				),
+			},
+			{
+				Config: testAccAWSDBInstanceConfig_MultiAZ(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBInstance_FinalSnapshotIdentifier(t *testing.T) {
 	var snap rds.DBInstance
 	rInt := acctest.RandInt()
@@ -5414,6 +5445,22 @@ resource "aws_db_instance" "test" {
 `, deletionProtection, rName))
 }
 
+func testAccAWSDBInstanceConfig_MultiAZ(rName string, multiAZ bool) string {
+	return composeConfig(testAccAWSDBInstanceConfig_orderableClassMysql(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage   = 5
+  apply_immediately   = true
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  multi_az            = %[2]t
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+  skip_final_snapshot = true
+}
+`, rName, multiAZ))
+}
+
 func testAccAWSDBInstanceConfig_EnabledCloudwatchLogsExports_Oracle(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_orderable_db_instance" "test" {
@@ -476,10 +476,20 @@ func elasticacheSetResourceDataFromCacheCluster(d *schema.ResourceData, c *elast
 }
 
 func elasticacheSetResourceDataEngineVersionFromCacheCluster(d *schema.ResourceData, c *elasticache.CacheCluster) error {
-	engineVersion, err := gversion.NewVersion(aws.StringValue(c.EngineVersion))
-	if err != nil {
+	if err := elasticacheSetResourceDataEngineVersion(d, aws.StringValue(c.EngineVersion)); err != nil {
 		return fmt.Errorf("error reading ElastiCache Cache Cluster (%s) engine version: %w", d.Id(), err)
 	}
+
+	return nil
+}
+
+// elasticacheSetResourceDataEngineVersion sets engine_version in the configured format (<major>.x for Redis 6 and higher)
+// and engine_version_actual to the full version.
+func elasticacheSetResourceDataEngineVersion(d *schema.ResourceData, version string) error {
+	engineVersion, err := gversion.NewVersion(version)
+	if err != nil {
+		return err
+	}
 	if engineVersion.Segments()[0] < 6 {
 		d.Set("engine_version", engineVersion.String())
 	} else {
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
@@ -44,6 +46,8 @@ func resourceAwsElasticacheGlobalReplicationGroup() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customizeDiffElasticacheGlobalReplicationGroupEngineVersion,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -69,11 +73,16 @@ func resourceAwsElasticacheGlobalReplicationGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			// Leaving space for `engine_version` for creation and updating.
 			// `engine_version` cannot be used for returning the version because, starting with Redis 6,
 			// version configuration is major-version-only: `engine_version = "6.x"`, while `engine_version_actual`
 			// will be e.g. `6.0.5`
 			// See also https://github.com/hashicorp/terraform-provider-aws/issues/15625
+			"engine_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: ValidateElastiCacheRedisVersionString,
+			},
 			"engine_version_actual": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -123,7 +132,6 @@ func resourceAwsElasticacheGlobalReplicationGroup() *schema.Resource {
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateReplicationGroupID,
 			},
 			"transit_encryption_enabled": {
@@ -168,10 +176,33 @@ func resourceAwsElasticacheGlobalReplicationGroupCreate(d *schema.ResourceData,
 
 	d.SetId(aws.StringValue(output.GlobalReplicationGroup.GlobalReplicationGroupId))
 
-	if _, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), waiter.GlobalReplicationGroupDefaultCreatedTimeout); err != nil {
+	globalReplicationGroup, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), waiter.GlobalReplicationGroupDefaultCreatedTimeout)
+	if err != nil {
 		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) availability: %w", d.Id(), err)
 	}
 
+	// The Global Replication Group is created with the primary's engine version. Upgrade if a newer version is requested.
+	if v, ok := d.GetOk("engine_version"); ok {
+		requested, err := NormalizeElastiCacheEngineVersion(v.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing engine_version: %w", err)
+		}
+
+		actual, err := gversion.NewVersion(aws.StringValue(globalReplicationGroup.EngineVersion))
+		if err != nil {
+			return fmt.Errorf("error reading ElastiCache Global Replication Group (%s) engine version: %w", d.Id(), err)
+		}
+
+		if requested.GreaterThan(actual) {
+			err := updateElasticacheGlobalReplicationGroup(conn, d.Id(), func(input *elasticache.ModifyGlobalReplicationGroupInput) {
+				input.EngineVersion = aws.String(v.(string))
+			})
+			if err != nil {
+				return fmt.Errorf("error updating ElastiCache Global Replication Group (%s) engine version: %w", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
 }
 
@@ -200,7 +231,9 @@ func resourceAwsElasticacheGlobalReplicationGroupRead(d *schema.ResourceData, me
 	d.Set("cache_node_type", globalReplicationGroup.CacheNodeType)
 	d.Set("cluster_enabled", globalReplicationGroup.ClusterEnabled)
 	d.Set("engine", globalReplicationGroup.Engine)
-	d.Set("engine_version_actual", globalReplicationGroup.EngineVersion)
+	if err := elasticacheSetResourceDataEngineVersion(d, aws.StringValue(globalReplicationGroup.EngineVersion)); err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s) engine version: %w", d.Id(), err)
+	}
 	d.Set("actual_engine_version", globalReplicationGroup.EngineVersion)
 	d.Set("global_replication_group_description", globalReplicationGroup.GlobalReplicationGroupDescription)
 	d.Set("global_replication_group_id", globalReplicationGroup.GlobalReplicationGroupId)
@@ -214,12 +247,23 @@ func resourceAwsElasticacheGlobalReplicationGroupRead(d *schema.ResourceData, me
 func resourceAwsElasticacheGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
+	if d.HasChange("primary_replication_group_id") {
+		o, n := d.GetChange("primary_replication_group_id")
+
+		if err := failoverElasticacheGlobalReplicationGroup(conn, d.Id(), o.(string), n.(string)); err != nil {
+			return err
+		}
+	}
+
 	// Only one field can be changed per request
 	updaters := map[string]elasticacheGlobalReplicationGroupUpdater{}
 	if !d.IsNewResource() {
 		updaters["global_replication_group_description"] = func(input *elasticache.ModifyGlobalReplicationGroupInput) {
 			input.GlobalReplicationGroupDescription = aws.String(d.Get("global_replication_group_description").(string))
 		}
+		updaters["engine_version"] = func(input *elasticache.ModifyGlobalReplicationGroupInput) {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
 	}
 
 	for k, f := range updaters {
@@ -253,6 +297,88 @@ func updateElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id s
 	return nil
 }
 
+// failoverElasticacheGlobalReplicationGroup promotes the secondary Replication Group newPrimaryID to be the primary.
+func failoverElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id, oldPrimaryID, newPrimaryID string) error {
+	globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, id)
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", id, err)
+	}
+
+	var region string
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.ReplicationGroupId) == newPrimaryID {
+			region = aws.StringValue(member.ReplicationGroupRegion)
+			break
+		}
+	}
+	if region == "" {
+		return fmt.Errorf("error failing over ElastiCache Global Replication Group (%s): Replication Group (%s) is not a member", id, newPrimaryID)
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             aws.String(region),
+		PrimaryReplicationGroupId: aws.String(newPrimaryID),
+	}
+
+	log.Printf("[DEBUG] Failing over ElastiCache Global Replication Group: %s", input)
+	err = resource.Retry(waiter.GlobalReplicationGroupDefaultUpdatedTimeout, func() *resource.RetryError {
+		_, err := conn.FailoverGlobalReplicationGroup(input)
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if tfresource.TimedOut(err) {
+		_, err = conn.FailoverGlobalReplicationGroup(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error failing over ElastiCache Global Replication Group (%s) to Replication Group (%s): %w", id, newPrimaryID, err)
+	}
+
+	if _, err := waiter.GlobalReplicationGroupFailedOver(conn, id, oldPrimaryID, newPrimaryID, waiter.GlobalReplicationGroupDefaultUpdatedTimeout); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) failover to Replication Group (%s): %w", id, newPrimaryID, err)
+	}
+
+	if _, err := waiter.GlobalReplicationGroupAvailable(conn, id, waiter.GlobalReplicationGroupDefaultUpdatedTimeout); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) availability after failover: %w", id, err)
+	}
+
+	return nil
+}
+
+// customizeDiffElasticacheGlobalReplicationGroupEngineVersion returns an error if the engine version is being downgraded,
+// as a Global Replication Group cannot be re-created with its members in place
+func customizeDiffElasticacheGlobalReplicationGroupEngineVersion(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("engine_version") {
+		return nil
+	}
+
+	o, n := diff.GetChange("engine_version")
+	if o.(string) == "" {
+		return nil
+	}
+
+	oVersion, err := NormalizeElastiCacheEngineVersion(o.(string))
+	if err != nil {
+		return fmt.Errorf("error parsing old engine_version: %w", err)
+	}
+	nVersion, err := NormalizeElastiCacheEngineVersion(n.(string))
+	if err != nil {
+		return fmt.Errorf("error parsing new engine_version: %w", err)
+	}
+
+	if nVersion.LessThan(oVersion) {
+		return fmt.Errorf("engine_version cannot be downgraded from %s to %s", o, n)
+	}
+
+	return nil
+}
+
 func resourceAwsElasticacheGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
@@ -447,6 +447,10 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		if _, err := waiter.GlobalReplicationGroupAvailable(conn, v.(string), waiter.GlobalReplicationGroupDefaultCreatedTimeout); err != nil {
 			return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) availability: %w", v, err)
 		}
+
+		if _, err := waiter.GlobalReplicationGroupMemberAssociated(conn, v.(string), d.Id()); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) to be associated with Global Replication Group (%s): %w", d.Id(), v, err)
+		}
 	}
 
 	return resourceAwsElasticacheReplicationGroupRead(d, meta)
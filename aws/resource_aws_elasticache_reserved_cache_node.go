@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsElasticacheReservedCacheNode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheReservedCacheNodeCreate,
+		Read:   resourceAwsElasticacheReservedCacheNodeRead,
+		Update: resourceAwsElasticacheReservedCacheNodeUpdate,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"reserved_cache_nodes_offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheReservedCacheNodeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &elasticache.PurchaseReservedCacheNodesOfferingInput{
+		CacheNodeCount:               aws.Int64(int64(d.Get("cache_node_count").(int))),
+		ReservedCacheNodesOfferingId: aws.String(d.Get("reserved_cache_nodes_offering_id").(string)),
+	}
+
+	if v, ok := d.GetOk("reservation_id"); ok {
+		input.ReservedCacheNodeId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ElasticacheTags()
+	}
+
+	log.Printf("[DEBUG] Purchasing ElastiCache Reserved Cache Node: %s", input)
+	output, err := conn.PurchaseReservedCacheNodesOffering(input)
+
+	if err != nil {
+		return fmt.Errorf("error purchasing ElastiCache Reserved Cache Node: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.ReservedCacheNode.ReservedCacheNodeId))
+
+	if _, err := waiter.ReservedCacheNodeActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Reserved Cache Node (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceAwsElasticacheReservedCacheNodeRead(d, meta)
+}
+
+func resourceAwsElasticacheReservedCacheNodeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	node, err := finder.ReservedCacheNodeByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Reserved Cache Node (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(node.ReservationARN)
+	d.Set("arn", arn)
+	d.Set("cache_node_count", node.CacheNodeCount)
+	d.Set("cache_node_type", node.CacheNodeType)
+	d.Set("duration", node.Duration)
+	d.Set("fixed_price", node.FixedPrice)
+	d.Set("offering_type", node.OfferingType)
+	d.Set("product_description", node.ProductDescription)
+	if err := d.Set("recurring_charges", flattenElasticacheRecurringCharges(node.RecurringCharges)); err != nil {
+		return fmt.Errorf("error setting recurring_charges: %w", err)
+	}
+	d.Set("reservation_id", node.ReservedCacheNodeId)
+	d.Set("reserved_cache_nodes_offering_id", node.ReservedCacheNodesOfferingId)
+	if node.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(node.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("state", node.State)
+	d.Set("usage_price", node.UsagePrice)
+
+	tags, err := keyvaluetags.ElasticacheListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for ElastiCache Reserved Cache Node (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsElasticacheReservedCacheNodeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ElasticacheUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating ElastiCache Reserved Cache Node (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsElasticacheReservedCacheNodeRead(d, meta)
+}
+
+func flattenElasticacheRecurringCharges(apiObjects []*elasticache.RecurringCharge) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"recurring_charge_amount":    aws.Float64Value(apiObject.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.StringValue(apiObject.RecurringChargeFrequency),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+)
+
+func TestAccAWSElasticacheReservedCacheNode_basic(t *testing.T) {
+	key := "RUN_ELASTICACHE_RESERVED_CACHE_NODE_TESTS"
+	if os.Getenv(key) != "true" {
+		t.Skipf("Environment variable %s is not set to true; purchasing a reserved cache node incurs costs", key)
+	}
+
+	var node elasticache.ReservedCacheNode
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_reserved_cache_node.test"
+	dataSourceName := "data.aws_elasticache_reserved_cache_node_offering.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, elasticache.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheReservedCacheNodeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReservedCacheNodeExists(resourceName, &node),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "elasticache", regexp.MustCompile(`reserved-instance:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cache_node_count", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cache_node_type", dataSourceName, "cache_node_type"),
+					resource.TestCheckResourceAttr(resourceName, "duration", "31536000"),
+					resource.TestCheckResourceAttrPair(resourceName, "offering_type", dataSourceName, "offering_type"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "reserved_cache_nodes_offering_id", dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSElasticacheReservedCacheNodeExists(n string, v *elasticache.ReservedCacheNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache Reserved Cache Node ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+		output, err := finder.ReservedCacheNodeByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSElasticacheReservedCacheNodeConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_elasticache_reserved_cache_node_offering" "test" {
+  cache_node_type     = "cache.t3.micro"
+  duration            = 1
+  offering_type       = "No Upfront"
+  product_description = "redis"
+}
+
+resource "aws_elasticache_reserved_cache_node" "test" {
+  reserved_cache_nodes_offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  reservation_id                   = %[1]q
+  cache_node_count                 = 1
+}
+`, rName)
+}
@@ -35,6 +35,10 @@ func resourceAwsEMRCluster() *schema.Resource {
 
 		CustomizeDiff: SetTagsDiff,
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(75 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -353,12 +357,11 @@ func resourceAwsEMRCluster() *schema.Resource {
 				Computed:      true,
 				MaxItems:      1,
 				ConflictsWith: []string{"core_instance_group", "master_instance_group"},
-				Elem:          InstanceFleetConfigSchema(),
+				Elem:          masterInstanceFleetConfigSchema(),
 			},
 			"core_instance_fleet": {
 				Type:          schema.TypeList,
 				Optional:      true,
-				ForceNew:      true,
 				Computed:      true,
 				MaxItems:      1,
 				ConflictsWith: []string{"core_instance_group", "master_instance_group"},
@@ -519,6 +522,17 @@ func resourceAwsEMRCluster() *schema.Resource {
 	}
 }
 
+// masterInstanceFleetConfigSchema returns the instance fleet schema for the master fleet.
+// Only the core instance fleet's target capacities can be modified in place.
+func masterInstanceFleetConfigSchema() *schema.Resource {
+	r := InstanceFleetConfigSchema()
+
+	r.Schema["target_on_demand_capacity"].ForceNew = true
+	r.Schema["target_spot_capacity"].ForceNew = true
+
+	return r
+}
+
 func InstanceFleetConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -678,13 +692,11 @@ func InstanceFleetConfigSchema() *schema.Resource {
 			"target_on_demand_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Default:  0,
 			},
 			"target_spot_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Default:  0,
 			},
 			"provisioned_on_demand_capacity": {
@@ -1289,6 +1301,40 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChanges("core_instance_fleet.0.target_on_demand_capacity", "core_instance_fleet.0.target_spot_capacity") {
+		instanceFleetID := d.Get("core_instance_fleet.0.id").(string)
+
+		input := &emr.ModifyInstanceFleetInput{
+			ClusterId: aws.String(d.Id()),
+			InstanceFleet: &emr.InstanceFleetModifyConfig{
+				InstanceFleetId:        aws.String(instanceFleetID),
+				TargetOnDemandCapacity: aws.Int64(int64(d.Get("core_instance_fleet.0.target_on_demand_capacity").(int))),
+				TargetSpotCapacity:     aws.Int64(int64(d.Get("core_instance_fleet.0.target_spot_capacity").(int))),
+			},
+		}
+
+		if _, err := conn.ModifyInstanceFleet(input); err != nil {
+			return fmt.Errorf("error modifying EMR Cluster (%s) Instance Fleet (%s): %w", d.Id(), instanceFleetID, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				emr.InstanceFleetStateBootstrapping,
+				emr.InstanceFleetStateProvisioning,
+				emr.InstanceFleetStateResizing,
+			},
+			Target:     []string{emr.InstanceFleetStateRunning},
+			Refresh:    instanceFleetStateRefresh(conn, d.Id(), instanceFleetID),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 30 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for EMR Cluster (%s) Instance Fleet (%s) modification: %w", d.Id(), instanceFleetID, err)
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
@@ -1402,7 +1402,7 @@ func TestAccAWSEMRCluster_InstanceFleet_basic(t *testing.T) {
 		CheckDestroy: testAccCheckAWSEmrDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAWSEmrClusterConfig_InstanceFleets(rName),
+				Config: testAccAWSEmrClusterConfig_InstanceFleets(rName, 2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSEmrClusterExists(resourceName, &cluster1),
 					resource.TestCheckResourceAttr(resourceName, "master_instance_fleet.#", "1"),
@@ -1454,6 +1454,39 @@ func TestAccAWSEMRCluster_InstanceFleet_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSEMRCluster_InstanceFleet_targetCapacity(t *testing.T) {
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.tf-test-cluster"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emr.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEmrDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEmrClusterConfig_InstanceFleets(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", "2"),
+				),
+			},
+			{
+				Config: testAccAWSEmrClusterConfig_InstanceFleets(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrClusterExists(resourceName, &cluster2),
+					testAccCheckAWSEmrClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSEMRCluster_InstanceFleet_master_only(t *testing.T) {
 	var cluster emr.Cluster
 
@@ -3530,7 +3563,7 @@ resource "aws_emr_cluster" "tf-test-cluster" {
 	)
 }
 
-func testAccAWSEmrClusterConfig_InstanceFleets(r string) string {
+func testAccAWSEmrClusterConfig_InstanceFleets(r string, targetSpotCapacity int) string {
 	return testAccAWSEmrComposeConfig(false,
 		testAccAWSEmrClusterConfigCurrentPartition(),
 		testAccAWSEmrClusterConfigIAMServiceRoleBase(r),
@@ -3591,7 +3624,7 @@ resource "aws_emr_cluster" "tf-test-cluster" {
     }
     name                      = "core fleet"
     target_on_demand_capacity = 0
-    target_spot_capacity      = 2
+    target_spot_capacity      = %[2]d
   }
   service_role = aws_iam_role.emr_service.arn
   depends_on = [
@@ -3613,7 +3646,7 @@ resource "aws_emr_cluster" "tf-test-cluster" {
     args = ["instance.isMaster=true", "echo running on master node"]
   }
 }
-`, r),
+`, r, targetSpotCapacity),
 	)
 }
 
@@ -13,8 +13,9 @@ import (
 
 func resourceAwsEMRManagedScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsEMRManagedScalingPolicyCreate,
+		Create: resourceAwsEMRManagedScalingPolicyPut,
 		Read:   resourceAwsEMRManagedScalingPolicyRead,
+		Update: resourceAwsEMRManagedScalingPolicyPut,
 		Delete: resourceAwsEMRManagedScalingPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -29,34 +30,28 @@ func resourceAwsEMRManagedScalingPolicy() *schema.Resource {
 			"compute_limits": {
 				Type:     schema.TypeSet,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"unit_type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(emr.ComputeLimitsUnitType_Values(), false),
 						},
 						"minimum_capacity_units": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"maximum_capacity_units": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"maximum_core_capacity_units": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 						"maximum_ondemand_capacity_units": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -65,7 +60,7 @@ func resourceAwsEMRManagedScalingPolicy() *schema.Resource {
 	}
 }
 
-func resourceAwsEMRManagedScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsEMRManagedScalingPolicyPut(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).emrconn
 
 	if l := d.Get("compute_limits").(*schema.Set).List(); len(l) > 0 && l[0] != nil {
@@ -97,7 +92,8 @@ func resourceAwsEMRManagedScalingPolicyCreate(d *schema.ResourceData, meta inter
 	}
 
 	d.SetId(d.Get("cluster_id").(string))
-	return nil
+
+	return resourceAwsEMRManagedScalingPolicyRead(d, meta)
 }
 
 func resourceAwsEMRManagedScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
@@ -58,6 +58,16 @@ func TestAccAwsEmrManagedScalingPolicy_ComputeLimits_MaximumCoreCapacityUnits(t
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccAWSEmrManagedScalingPolicy_ComputeLimits_MaximumCoreCapacityUnits(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEmrManagedScalingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_limits.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "compute_limits.*", map[string]string{
+						"maximum_core_capacity_units": "1",
+					}),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,311 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emrcontainers/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emrcontainers/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEMRContainersVirtualCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEMRContainersVirtualClusterCreate,
+		Read:   resourceAwsEMRContainersVirtualClusterRead,
+		Update: resourceAwsEMRContainersVirtualClusterUpdate,
+		Delete: resourceAwsEMRContainersVirtualClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_provider": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"info": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eks_info": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"namespace": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(emrcontainers.ContainerProviderType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[.\-_/#A-Za-z0-9]+$`), "must contain only letters, numbers, and the characters .-_/#"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsEMRContainersVirtualClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrcontainersconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &emrcontainers.CreateVirtualClusterInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("container_provider"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ContainerProvider = expandEMRContainersContainerProvider(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().EmrcontainersTags()
+	}
+
+	log.Printf("[DEBUG] Creating EMR Containers Virtual Cluster: %s", input)
+	output, err := conn.CreateVirtualCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EMR Containers Virtual Cluster (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceAwsEMRContainersVirtualClusterRead(d, meta)
+}
+
+func resourceAwsEMRContainersVirtualClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrcontainersconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	vc, err := finder.VirtualClusterByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Containers Virtual Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EMR Containers Virtual Cluster (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", vc.Arn)
+	if vc.ContainerProvider != nil {
+		if err := d.Set("container_provider", []interface{}{flattenEMRContainersContainerProvider(vc.ContainerProvider)}); err != nil {
+			return fmt.Errorf("error setting container_provider: %w", err)
+		}
+	} else {
+		d.Set("container_provider", nil)
+	}
+	d.Set("name", vc.Name)
+
+	tags := keyvaluetags.EmrcontainersKeyValueTags(vc.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEMRContainersVirtualClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrcontainersconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.EmrcontainersUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating EMR Containers Virtual Cluster (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEMRContainersVirtualClusterRead(d, meta)
+}
+
+func resourceAwsEMRContainersVirtualClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).emrcontainersconn
+
+	log.Printf("[INFO] Deleting EMR Containers Virtual Cluster: %s", d.Id())
+	_, err := conn.DeleteVirtualCluster(&emrcontainers.DeleteVirtualClusterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EMR Containers Virtual Cluster (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.VirtualClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EMR Containers Virtual Cluster (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEMRContainersContainerProvider(tfMap map[string]interface{}) *emrcontainers.ContainerProvider {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ContainerProvider{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Info = expandEMRContainersContainerInfo(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEMRContainersContainerInfo(tfMap map[string]interface{}) *emrcontainers.ContainerInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ContainerInfo{}
+
+	if v, ok := tfMap["eks_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EksInfo = expandEMRContainersEksInfo(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEMRContainersEksInfo(tfMap map[string]interface{}) *emrcontainers.EksInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.EksInfo{}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEMRContainersContainerProvider(apiObject *emrcontainers.ContainerProvider) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Id; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Info; v != nil {
+		tfMap["info"] = []interface{}{flattenEMRContainersContainerInfo(v)}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenEMRContainersContainerInfo(apiObject *emrcontainers.ContainerInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EksInfo; v != nil {
+		tfMap["eks_info"] = []interface{}{flattenEMRContainersEksInfo(v)}
+	}
+
+	return tfMap
+}
+
+func flattenEMRContainersEksInfo(apiObject *emrcontainers.EksInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Namespace; v != nil {
+		tfMap["namespace"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
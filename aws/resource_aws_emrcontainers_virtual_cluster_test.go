@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emrcontainers/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// EMR on EKS requires the EKS cluster to grant the EMR service-linked role access
+// to the namespace, which cannot be configured with this provider alone.
+const emrContainersEksClusterNameEnvVar = "EMR_CONTAINERS_EKS_CLUSTER_NAME"
+
+func testAccPreCheckAWSEMRContainersEksClusterName(t *testing.T) string {
+	v := os.Getenv(emrContainersEksClusterNameEnvVar)
+
+	if v == "" {
+		t.Skipf("Environment variable %s is not set to the name of an EKS cluster prepared for EMR on EKS", emrContainersEksClusterNameEnvVar)
+	}
+
+	return v
+}
+
+func TestAccAWSEMRContainersVirtualCluster_basic(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	eksClusterName := testAccPreCheckAWSEMRContainersEksClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRContainersVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfig(rName, eksClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "emr-containers", regexp.MustCompile(`/virtualclusters/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.id", eksClusterName),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.type", emrcontainers.ContainerProviderTypeEks),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRContainersVirtualCluster_disappears(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	eksClusterName := testAccPreCheckAWSEMRContainersEksClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRContainersVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfig(rName, eksClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEMRContainersVirtualCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRContainersVirtualCluster_tags(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	eksClusterName := testAccPreCheckAWSEMRContainersEksClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRContainersVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigTags1(rName, eksClusterName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigTags2(rName, eksClusterName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigTags1(rName, eksClusterName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEMRContainersVirtualClusterExists(n string, v *emrcontainers.VirtualCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Containers Virtual Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).emrcontainersconn
+
+		output, err := finder.VirtualClusterByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEMRContainersVirtualClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).emrcontainersconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emrcontainers_virtual_cluster" {
+			continue
+		}
+
+		_, err := finder.VirtualClusterByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EMR Containers Virtual Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEMRContainersVirtualClusterConfig(rName, eksClusterName string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+}
+`, rName, eksClusterName)
+}
+
+func testAccAWSEMRContainersVirtualClusterConfigTags1(rName, eksClusterName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, eksClusterName, tagKey1, tagValue1)
+}
+
+func testAccAWSEMRContainersVirtualClusterConfigTags2(rName, eksClusterName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, eksClusterName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
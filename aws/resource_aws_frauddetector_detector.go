@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsFraudDetectorDetector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFraudDetectorDetectorCreate,
+		Read:   resourceAwsFraudDetectorDetectorRead,
+		Update: resourceAwsFraudDetectorDetectorUpdate,
+		Delete: resourceAwsFraudDetectorDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"event_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsFraudDetectorDetectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	detectorID := d.Get("detector_id").(string)
+	input := &frauddetector.PutDetectorInput{
+		DetectorId:    aws.String(detectorID),
+		EventTypeName: aws.String(d.Get("event_type_name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().FrauddetectorTags()
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Detector: %s", input)
+	_, err := conn.PutDetector(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Detector (%s): %w", detectorID, err)
+	}
+
+	d.SetId(detectorID)
+
+	return resourceAwsFraudDetectorDetectorRead(d, meta)
+}
+
+func resourceAwsFraudDetectorDetectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	detector, err := finder.DetectorByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Detector (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(detector.Arn)
+	d.Set("arn", arn)
+	d.Set("description", detector.Description)
+	d.Set("detector_id", detector.DetectorId)
+	d.Set("event_type_name", detector.EventTypeName)
+
+	tags, err := keyvaluetags.FrauddetectorListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Detector (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFraudDetectorDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	if d.HasChange("description") {
+		input := &frauddetector.PutDetectorInput{
+			Description:   aws.String(d.Get("description").(string)),
+			DetectorId:    aws.String(d.Id()),
+			EventTypeName: aws.String(d.Get("event_type_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Detector: %s", input)
+		_, err := conn.PutDetector(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Detector (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FrauddetectorUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Detector (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorDetectorRead(d, meta)
+}
+
+func resourceAwsFraudDetectorDetectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Detector: %s", d.Id())
+	_, err := conn.DeleteDetector(&frauddetector.DeleteDetectorInput{
+		DetectorId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Detector (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
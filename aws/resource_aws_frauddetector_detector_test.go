@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorDetector_basic(t *testing.T) {
+	var v frauddetector.Detector
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorDetectorExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "frauddetector", regexp.MustCompile(`detector/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "detector_id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "event_type_name", "aws_frauddetector_event_type.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorDetector_disappears(t *testing.T) {
+	var v frauddetector.Detector
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorDetectorExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFraudDetectorDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorDetector_tags(t *testing.T) {
+	var v frauddetector.Detector
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorDetectorConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorDetectorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorDetectorConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorDetectorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSFraudDetectorDetectorConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorDetectorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorDetectorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_detector" {
+			continue
+		}
+
+		_, err := finder.DetectorByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Detector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorDetectorExists(n string, v *frauddetector.Detector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Detector ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.DetectorByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFraudDetectorDetectorConfigBase(rName string) string {
+	return composeConfig(testAccAWSFraudDetectorEventTypeConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_outcome" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccAWSFraudDetectorDetectorConfig(rName string) string {
+	return composeConfig(testAccAWSFraudDetectorDetectorConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+`, rName))
+}
+
+func testAccAWSFraudDetectorDetectorConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSFraudDetectorDetectorConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  event_type_name = aws_frauddetector_event_type.test.name
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSFraudDetectorDetectorConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSFraudDetectorDetectorConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_detector" "test" {
+  detector_id     = %[1]q
+  event_type_name = aws_frauddetector_event_type.test.name
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
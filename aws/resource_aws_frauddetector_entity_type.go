@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsFraudDetectorEntityType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFraudDetectorEntityTypeCreate,
+		Read:   resourceAwsFraudDetectorEntityTypeRead,
+		Update: resourceAwsFraudDetectorEntityTypeUpdate,
+		Delete: resourceAwsFraudDetectorEntityTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsFraudDetectorEntityTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &frauddetector.PutEntityTypeInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().FrauddetectorTags()
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Entity Type: %s", input)
+	_, err := conn.PutEntityType(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Entity Type (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsFraudDetectorEntityTypeRead(d, meta)
+}
+
+func resourceAwsFraudDetectorEntityTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	entityType, err := finder.EntityTypeByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Entity Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Entity Type (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(entityType.Arn)
+	d.Set("arn", arn)
+	d.Set("description", entityType.Description)
+	d.Set("name", entityType.Name)
+
+	tags, err := keyvaluetags.FrauddetectorListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Entity Type (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFraudDetectorEntityTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	if d.HasChange("description") {
+		input := &frauddetector.PutEntityTypeInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Entity Type: %s", input)
+		_, err := conn.PutEntityType(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Entity Type (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FrauddetectorUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Entity Type (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorEntityTypeRead(d, meta)
+}
+
+func resourceAwsFraudDetectorEntityTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Entity Type: %s", d.Id())
+	_, err := conn.DeleteEntityType(&frauddetector.DeleteEntityTypeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Entity Type (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
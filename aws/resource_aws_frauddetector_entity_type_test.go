@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorEntityType_basic(t *testing.T) {
+	var v frauddetector.EntityType
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorEntityTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEntityTypeExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "frauddetector", regexp.MustCompile(`entity-type/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorEntityType_disappears(t *testing.T) {
+	var v frauddetector.EntityType
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorEntityTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEntityTypeExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFraudDetectorEntityType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorEntityType_Description(t *testing.T) {
+	var v frauddetector.EntityType
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorEntityTypeConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorEntityTypeConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorEntityType_tags(t *testing.T) {
+	var v frauddetector.EntityType
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_entity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorEntityTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorEntityTypeConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorEntityTypeConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSFraudDetectorEntityTypeConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEntityTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorEntityTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_entity_type" {
+			continue
+		}
+
+		_, err := finder.EntityTypeByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Entity Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorEntityTypeExists(n string, v *frauddetector.EntityType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Entity Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.EntityTypeByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFraudDetectorEntityTypeConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSFraudDetectorEntityTypeConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccAWSFraudDetectorEntityTypeConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSFraudDetectorEntityTypeConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
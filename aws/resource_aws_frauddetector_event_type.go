@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsFraudDetectorEventType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFraudDetectorEventTypeCreate,
+		Read:   resourceAwsFraudDetectorEventTypeRead,
+		Update: resourceAwsFraudDetectorEventTypeUpdate,
+		Delete: resourceAwsFraudDetectorEventTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"entity_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_variables": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_]+$`), "must contain only lowercase alphanumeric characters and underscores"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsFraudDetectorEventTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := expandFraudDetectorPutEventTypeInput(d)
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().FrauddetectorTags()
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Event Type: %s", input)
+	_, err := conn.PutEventType(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Event Type (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsFraudDetectorEventTypeRead(d, meta)
+}
+
+func resourceAwsFraudDetectorEventTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	eventType, err := finder.EventTypeByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Event Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Event Type (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(eventType.Arn)
+	d.Set("arn", arn)
+	d.Set("description", eventType.Description)
+	d.Set("entity_types", aws.StringValueSlice(eventType.EntityTypes))
+	d.Set("event_variables", aws.StringValueSlice(eventType.EventVariables))
+	d.Set("labels", aws.StringValueSlice(eventType.Labels))
+	d.Set("name", eventType.Name)
+
+	tags, err := keyvaluetags.FrauddetectorListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Event Type (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFraudDetectorEventTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := expandFraudDetectorPutEventTypeInput(d)
+
+		log.Printf("[DEBUG] Updating Fraud Detector Event Type: %s", input)
+		_, err := conn.PutEventType(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Event Type (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FrauddetectorUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Event Type (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorEventTypeRead(d, meta)
+}
+
+func resourceAwsFraudDetectorEventTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Event Type: %s", d.Id())
+	_, err := conn.DeleteEventType(&frauddetector.DeleteEventTypeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Event Type (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandFraudDetectorPutEventTypeInput builds the PutEventType input from configuration.
+// PutEventType is an upsert so the same input is used for creation and update.
+func expandFraudDetectorPutEventTypeInput(d *schema.ResourceData) *frauddetector.PutEventTypeInput {
+	input := &frauddetector.PutEventTypeInput{
+		EntityTypes:    expandStringSet(d.Get("entity_types").(*schema.Set)),
+		EventVariables: expandStringSet(d.Get("event_variables").(*schema.Set)),
+		Name:           aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("labels"); ok && v.(*schema.Set).Len() > 0 {
+		input.Labels = expandStringSet(v.(*schema.Set))
+	}
+
+	return input
+}
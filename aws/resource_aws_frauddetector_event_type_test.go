@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorEventType_basic(t *testing.T) {
+	var v frauddetector.EventType
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorEventTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorEventTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEventTypeExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "frauddetector", regexp.MustCompile(`event-type/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "entity_types.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entity_types.*", "aws_frauddetector_entity_type.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "event_variables.*", "aws_frauddetector_variable.email", "name"),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorEventTypeConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEventTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "event_variables.*", "aws_frauddetector_variable.ip", "name"),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "labels.*", "aws_frauddetector_label.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorEventType_disappears(t *testing.T) {
+	var v frauddetector.EventType
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorEventTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorEventTypeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorEventTypeExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFraudDetectorEventType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorEventTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_event_type" {
+			continue
+		}
+
+		_, err := finder.EventTypeByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Event Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorEventTypeExists(n string, v *frauddetector.EventType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Event Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.EventTypeByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFraudDetectorEventTypeConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+
+resource "aws_frauddetector_variable" "email" {
+  name          = "%[1]s_email"
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "EMAIL_ADDRESS"
+}
+
+resource "aws_frauddetector_variable" "ip" {
+  name          = "%[1]s_ip"
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "IP_ADDRESS"
+}
+
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSFraudDetectorEventTypeConfig(rName string) string {
+	return composeConfig(testAccAWSFraudDetectorEventTypeConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.email.name]
+}
+`, rName))
+}
+
+func testAccAWSFraudDetectorEventTypeConfigUpdated(rName string) string {
+	return composeConfig(testAccAWSFraudDetectorEventTypeConfigBase(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.email.name, aws_frauddetector_variable.ip.name]
+  labels          = [aws_frauddetector_label.test.name]
+}
+`, rName))
+}
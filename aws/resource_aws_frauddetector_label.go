@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsFraudDetectorLabel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFraudDetectorLabelCreate,
+		Read:   resourceAwsFraudDetectorLabelRead,
+		Update: resourceAwsFraudDetectorLabelUpdate,
+		Delete: resourceAwsFraudDetectorLabelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsFraudDetectorLabelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &frauddetector.PutLabelInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().FrauddetectorTags()
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Label: %s", input)
+	_, err := conn.PutLabel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Label (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsFraudDetectorLabelRead(d, meta)
+}
+
+func resourceAwsFraudDetectorLabelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	label, err := finder.LabelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Label (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Label (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(label.Arn)
+	d.Set("arn", arn)
+	d.Set("description", label.Description)
+	d.Set("name", label.Name)
+
+	tags, err := keyvaluetags.FrauddetectorListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Label (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFraudDetectorLabelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	if d.HasChange("description") {
+		input := &frauddetector.PutLabelInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Label: %s", input)
+		_, err := conn.PutLabel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Label (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FrauddetectorUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Label (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorLabelRead(d, meta)
+}
+
+func resourceAwsFraudDetectorLabelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Label: %s", d.Id())
+	_, err := conn.DeleteLabel(&frauddetector.DeleteLabelInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Label (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorLabel_basic(t *testing.T) {
+	var v frauddetector.Label
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorLabelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorLabelExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "frauddetector", regexp.MustCompile(`label/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorLabel_disappears(t *testing.T) {
+	var v frauddetector.Label
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorLabelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorLabelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFraudDetectorLabel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorLabel_Description(t *testing.T) {
+	var v frauddetector.Label
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorLabelConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorLabelConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorLabel_tags(t *testing.T) {
+	var v frauddetector.Label
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorLabelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorLabelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSFraudDetectorLabelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorLabelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorLabelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_label" {
+			continue
+		}
+
+		_, err := finder.LabelByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Label %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorLabelExists(n string, v *frauddetector.Label) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Label ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.LabelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFraudDetectorLabelConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSFraudDetectorLabelConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccAWSFraudDetectorLabelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSFraudDetectorLabelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
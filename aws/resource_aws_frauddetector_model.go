@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tffrauddetector "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsFraudDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFraudDetectorModelCreate,
+		Read:   resourceAwsFraudDetectorModelRead,
+		Update: resourceAwsFraudDetectorModelUpdate,
+		Delete: resourceAwsFraudDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"event_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"model_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_]+$`), "must contain only lowercase alphanumeric characters and underscores"),
+				),
+			},
+			"model_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.ModelTypeEnum_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsFraudDetectorModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	modelID := d.Get("model_id").(string)
+	modelType := d.Get("model_type").(string)
+	id := tffrauddetector.ModelCreateResourceID(modelID, modelType)
+	input := &frauddetector.CreateModelInput{
+		EventTypeName: aws.String(d.Get("event_type_name").(string)),
+		ModelId:       aws.String(modelID),
+		ModelType:     aws.String(modelType),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().FrauddetectorTags()
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Model: %s", input)
+	_, err := conn.CreateModel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Model (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsFraudDetectorModelRead(d, meta)
+}
+
+func resourceAwsFraudDetectorModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	modelID, modelType, err := tffrauddetector.ModelParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	model, err := finder.ModelByIDAndType(conn, modelID, modelType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Model (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(model.Arn)
+	d.Set("arn", arn)
+	d.Set("description", model.Description)
+	d.Set("event_type_name", model.EventTypeName)
+	d.Set("model_id", model.ModelId)
+	d.Set("model_type", model.ModelType)
+
+	tags, err := keyvaluetags.FrauddetectorListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Model (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFraudDetectorModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	if d.HasChange("description") {
+		input := &frauddetector.UpdateModelInput{
+			Description: aws.String(d.Get("description").(string)),
+			ModelId:     aws.String(d.Get("model_id").(string)),
+			ModelType:   aws.String(d.Get("model_type").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Model: %s", input)
+		_, err := conn.UpdateModel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Model (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FrauddetectorUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorModelRead(d, meta)
+}
+
+func resourceAwsFraudDetectorModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Model: %s", d.Id())
+	_, err := conn.DeleteModel(&frauddetector.DeleteModelInput{
+		ModelId:   aws.String(d.Get("model_id").(string)),
+		ModelType: aws.String(d.Get("model_type").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Model (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tffrauddetector "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorModel_basic(t *testing.T) {
+	var v frauddetector.Model
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorModelConfig(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorModelExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "frauddetector", regexp.MustCompile(`model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_type_name", "aws_frauddetector_event_type.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "model_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "model_type", frauddetector.ModelTypeEnumOnlineFraudInsights),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorModelConfig(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorModel_disappears(t *testing.T) {
+	var v frauddetector.Model
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorModelConfig(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorModelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFraudDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_model" {
+			continue
+		}
+
+		modelID, modelType, err := tffrauddetector.ModelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ModelByIDAndType(conn, modelID, modelType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorModelExists(n string, v *frauddetector.Model) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Model ID is set")
+		}
+
+		modelID, modelType, err := tffrauddetector.ModelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.ModelByIDAndType(conn, modelID, modelType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFraudDetectorModelConfig(rName, description string) string {
+	return composeConfig(testAccAWSFraudDetectorEventTypeConfigUpdated(rName), fmt.Sprintf(`
+resource "aws_frauddetector_model" "test" {
+  model_id        = %[1]q
+  model_type      = "ONLINE_FRAUD_INSIGHTS"
+  event_type_name = aws_frauddetector_event_type.test.name
+  description     = %[2]q
+}
+`, rName, description))
+}
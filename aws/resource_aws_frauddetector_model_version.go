@@ -0,0 +1,427 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tffrauddetector "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsFraudDetectorModelVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFraudDetectorModelVersionCreate,
+		Read:   resourceAwsFraudDetectorModelVersionRead,
+		Update: resourceAwsFraudDetectorModelVersionUpdate,
+		Delete: resourceAwsFraudDetectorModelVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_events_detail": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"data_location": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"model_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"model_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.ModelTypeEnum_Values(), false),
+			},
+			"model_version_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{tffrauddetector.ModelVersionStatusActive, tffrauddetector.ModelVersionStatusInactive}, false),
+			},
+			"training_data_schema": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label_mapper": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"model_variables": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"training_data_source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.TrainingDataSourceEnum_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsFraudDetectorModelVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	modelID := d.Get("model_id").(string)
+	modelType := d.Get("model_type").(string)
+	input := &frauddetector.CreateModelVersionInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		TrainingDataSource: aws.String(d.Get("training_data_source").(string)),
+	}
+
+	if v, ok := d.GetOk("external_events_detail"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExternalEventsDetail = expandFraudDetectorExternalEventsDetail(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("training_data_schema"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrainingDataSchema = expandFraudDetectorTrainingDataSchema(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().FrauddetectorTags()
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Model Version: %s", input)
+	output, err := conn.CreateModelVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Model Version (%s): %w", tffrauddetector.ModelCreateResourceID(modelID, modelType), err)
+	}
+
+	modelVersionNumber := aws.StringValue(output.ModelVersionNumber)
+	d.SetId(tffrauddetector.ModelVersionCreateResourceID(modelID, modelType, modelVersionNumber))
+
+	if _, err := waiter.ModelVersionTrained(conn, modelID, modelType, modelVersionNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Fraud Detector Model Version (%s) training to complete: %w", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) == tffrauddetector.ModelVersionStatusActive {
+		if err := fraudDetectorModelVersionUpdateStatus(conn, modelID, modelType, modelVersionNumber, v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error activating Fraud Detector Model Version (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorModelVersionRead(d, meta)
+}
+
+func resourceAwsFraudDetectorModelVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	modelID, modelType, modelVersionNumber, err := tffrauddetector.ModelVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	modelVersion, err := finder.ModelVersionByIDTypeAndVersionNumber(conn, modelID, modelType, modelVersionNumber)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Model Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Model Version (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(modelVersion.Arn)
+	d.Set("arn", arn)
+	if modelVersion.ExternalEventsDetail != nil {
+		if err := d.Set("external_events_detail", []interface{}{flattenFraudDetectorExternalEventsDetail(modelVersion.ExternalEventsDetail)}); err != nil {
+			return fmt.Errorf("error setting external_events_detail: %w", err)
+		}
+	} else {
+		d.Set("external_events_detail", nil)
+	}
+	d.Set("model_id", modelVersion.ModelId)
+	d.Set("model_type", modelVersion.ModelType)
+	d.Set("model_version_number", modelVersion.ModelVersionNumber)
+	d.Set("status", modelVersion.Status)
+	if modelVersion.TrainingDataSchema != nil {
+		if err := d.Set("training_data_schema", []interface{}{flattenFraudDetectorTrainingDataSchema(modelVersion.TrainingDataSchema)}); err != nil {
+			return fmt.Errorf("error setting training_data_schema: %w", err)
+		}
+	} else {
+		d.Set("training_data_schema", nil)
+	}
+	d.Set("training_data_source", modelVersion.TrainingDataSource)
+
+	tags, err := keyvaluetags.FrauddetectorListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Model Version (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFraudDetectorModelVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	if d.HasChange("status") {
+		modelID, modelType, modelVersionNumber, err := tffrauddetector.ModelVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if err := fraudDetectorModelVersionUpdateStatus(conn, modelID, modelType, modelVersionNumber, d.Get("status").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Model Version (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FrauddetectorUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Model Version (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorModelVersionRead(d, meta)
+}
+
+func resourceAwsFraudDetectorModelVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	modelID, modelType, modelVersionNumber, err := tffrauddetector.ModelVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Active model versions must be deactivated before they can be deleted.
+	if d.Get("status").(string) == tffrauddetector.ModelVersionStatusActive {
+		if err := fraudDetectorModelVersionUpdateStatus(conn, modelID, modelType, modelVersionNumber, tffrauddetector.ModelVersionStatusInactive, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("error deactivating Fraud Detector Model Version (%s): %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Fraud Detector Model Version: %s", d.Id())
+	_, err = conn.DeleteModelVersion(&frauddetector.DeleteModelVersionInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Fraud Detector Model Version (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func fraudDetectorModelVersionUpdateStatus(conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber, status string, timeout time.Duration) error {
+	input := &frauddetector.UpdateModelVersionStatusInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+		Status:             aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Fraud Detector Model Version status: %s", input)
+	_, err := conn.UpdateModelVersionStatus(input)
+
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case tffrauddetector.ModelVersionStatusActive:
+		_, err = waiter.ModelVersionActivated(conn, modelID, modelType, modelVersionNumber, timeout)
+	case tffrauddetector.ModelVersionStatusInactive:
+		_, err = waiter.ModelVersionInactivated(conn, modelID, modelType, modelVersionNumber, timeout)
+	}
+
+	return err
+}
+
+func expandFraudDetectorExternalEventsDetail(tfMap map[string]interface{}) *frauddetector.ExternalEventsDetail {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &frauddetector.ExternalEventsDetail{}
+
+	if v, ok := tfMap["data_access_role_arn"].(string); ok && v != "" {
+		apiObject.DataAccessRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["data_location"].(string); ok && v != "" {
+		apiObject.DataLocation = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFraudDetectorTrainingDataSchema(tfMap map[string]interface{}) *frauddetector.TrainingDataSchema {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &frauddetector.TrainingDataSchema{}
+
+	if v, ok := tfMap["label_mapper"].(*schema.Set); ok && v.Len() > 0 {
+		labelMapper := make(map[string][]*string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			labelMapper[tfMap["key"].(string)] = expandStringList(tfMap["values"].([]interface{}))
+		}
+
+		apiObject.LabelSchema = &frauddetector.LabelSchema{
+			LabelMapper: labelMapper,
+		}
+	}
+
+	if v, ok := tfMap["model_variables"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ModelVariables = expandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenFraudDetectorExternalEventsDetail(apiObject *frauddetector.ExternalEventsDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataAccessRoleArn; v != nil {
+		tfMap["data_access_role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DataLocation; v != nil {
+		tfMap["data_location"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFraudDetectorTrainingDataSchema(apiObject *frauddetector.TrainingDataSchema) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.LabelSchema != nil {
+		var tfList []interface{}
+
+		for k, v := range apiObject.LabelSchema.LabelMapper {
+			tfList = append(tfList, map[string]interface{}{
+				"key":    k,
+				"values": aws.StringValueSlice(v),
+			})
+		}
+
+		tfMap["label_mapper"] = tfList
+	}
+
+	if v := apiObject.ModelVariables; v != nil {
+		tfMap["model_variables"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
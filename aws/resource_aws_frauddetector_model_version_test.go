@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tffrauddetector "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorModelVersion_basic(t *testing.T) {
+	dataLocation := os.Getenv("FRAUDDETECTOR_TRAINING_DATA_LOCATION")
+	if dataLocation == "" {
+		t.Skip(
+			"Environment variable FRAUDDETECTOR_TRAINING_DATA_LOCATION is not set. " +
+				"This environment variable must be set to the S3 URI of a CSV file " +
+				"containing at least 10,000 events with email_address, ip_address and " +
+				"EVENT_LABEL columns, labeled fraud or legit, to enable the test.")
+	}
+
+	var v frauddetector.ModelVersionDetail
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_model_version.test"
+
+	// Variable names must match the training data columns, so they cannot be randomized
+	// and this test cannot be run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorModelVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorModelVersionConfig(rName, dataLocation, tffrauddetector.ModelVersionStatusActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorModelVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "external_events_detail.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_events_detail.0.data_location", dataLocation),
+					resource.TestCheckResourceAttrPair(resourceName, "model_id", "aws_frauddetector_model.test", "model_id"),
+					resource.TestCheckResourceAttr(resourceName, "model_version_number", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "status", tffrauddetector.ModelVersionStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.0.model_variables.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_source", frauddetector.TrainingDataSourceEnumExternalEvents),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorModelVersionConfig(rName, dataLocation, tffrauddetector.ModelVersionStatusInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorModelVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", tffrauddetector.ModelVersionStatusInactive),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorModelVersionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_model_version" {
+			continue
+		}
+
+		modelID, modelType, modelVersionNumber, err := tffrauddetector.ModelVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ModelVersionByIDTypeAndVersionNumber(conn, modelID, modelType, modelVersionNumber)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Model Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorModelVersionExists(n string, v *frauddetector.ModelVersionDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Model Version ID is set")
+		}
+
+		modelID, modelType, modelVersionNumber, err := tffrauddetector.ModelVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.ModelVersionByIDTypeAndVersionNumber(conn, modelID, modelType, modelVersionNumber)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFraudDetectorModelVersionConfig(rName, dataLocation, status string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+
+resource "aws_frauddetector_variable" "email" {
+  name          = "email_address"
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "EMAIL_ADDRESS"
+}
+
+resource "aws_frauddetector_variable" "ip" {
+  name          = "ip_address"
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "IP_ADDRESS"
+}
+
+resource "aws_frauddetector_label" "fraud" {
+  name = "fraud"
+}
+
+resource "aws_frauddetector_label" "legit" {
+  name = "legit"
+}
+
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.email.name, aws_frauddetector_variable.ip.name]
+  labels          = [aws_frauddetector_label.fraud.name, aws_frauddetector_label.legit.name]
+}
+
+resource "aws_frauddetector_model" "test" {
+  model_id        = %[1]q
+  model_type      = "ONLINE_FRAUD_INSIGHTS"
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "frauddetector.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonS3ReadOnlyAccess"
+}
+
+resource "aws_frauddetector_model_version" "test" {
+  model_id             = aws_frauddetector_model.test.model_id
+  model_type           = aws_frauddetector_model.test.model_type
+  training_data_source = "EXTERNAL_EVENTS"
+  status               = %[3]q
+
+  external_events_detail {
+    data_access_role_arn = aws_iam_role.test.arn
+    data_location        = %[2]q
+  }
+
+  training_data_schema {
+    model_variables = [aws_frauddetector_variable.email.name, aws_frauddetector_variable.ip.name]
+
+    label_mapper {
+      key    = "FRAUD"
+      values = [aws_frauddetector_label.fraud.name]
+    }
+
+    label_mapper {
+      key    = "LEGIT"
+      values = [aws_frauddetector_label.legit.name]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, dataLocation, status)
+}
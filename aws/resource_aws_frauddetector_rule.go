@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tffrauddetector "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsFraudDetectorRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFraudDetectorRuleCreate,
+		Read:   resourceAwsFraudDetectorRuleRead,
+		Update: resourceAwsFraudDetectorRuleUpdate,
+		Delete: resourceAwsFraudDetectorRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      frauddetector.LanguageDetectorpl,
+				ValidateFunc: validation.StringInSlice(frauddetector.Language_Values(), false),
+			},
+			"outcomes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"rule_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsFraudDetectorRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	detectorID := d.Get("detector_id").(string)
+	ruleID := d.Get("rule_id").(string)
+	id := tffrauddetector.RuleCreateResourceID(detectorID, ruleID)
+	input := &frauddetector.CreateRuleInput{
+		DetectorId: aws.String(detectorID),
+		Expression: aws.String(d.Get("expression").(string)),
+		Language:   aws.String(d.Get("language").(string)),
+		Outcomes:   expandStringList(d.Get("outcomes").([]interface{})),
+		RuleId:     aws.String(ruleID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().FrauddetectorTags()
+	}
+
+	log.Printf("[DEBUG] Creating Fraud Detector Rule: %s", input)
+	_, err := conn.CreateRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Fraud Detector Rule (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsFraudDetectorRuleRead(d, meta)
+}
+
+func resourceAwsFraudDetectorRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	detectorID, ruleID, err := tffrauddetector.RuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	versions, err := finder.RuleVersionsByDetectorIDAndRuleID(conn, detectorID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Rule (%s): %w", d.Id(), err)
+	}
+
+	rule := fraudDetectorLatestRuleVersion(versions)
+
+	arn := aws.StringValue(rule.Arn)
+	d.Set("arn", arn)
+	d.Set("description", rule.Description)
+	d.Set("detector_id", rule.DetectorId)
+	d.Set("expression", rule.Expression)
+	d.Set("language", rule.Language)
+	d.Set("outcomes", aws.StringValueSlice(rule.Outcomes))
+	d.Set("rule_id", rule.RuleId)
+	d.Set("rule_version", rule.RuleVersion)
+
+	tags, err := keyvaluetags.FrauddetectorListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Fraud Detector Rule (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFraudDetectorRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	rule := &frauddetector.Rule{
+		DetectorId:  aws.String(d.Get("detector_id").(string)),
+		RuleId:      aws.String(d.Get("rule_id").(string)),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+	}
+
+	// Changes to the rule logic create a new rule version, which carries its own tags.
+	if d.HasChanges("expression", "language", "outcomes") {
+		input := &frauddetector.UpdateRuleVersionInput{
+			Description: aws.String(d.Get("description").(string)),
+			Expression:  aws.String(d.Get("expression").(string)),
+			Language:    aws.String(d.Get("language").(string)),
+			Outcomes:    expandStringList(d.Get("outcomes").([]interface{})),
+			Rule:        rule,
+		}
+
+		if tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws(); len(tags) > 0 {
+			input.Tags = tags.FrauddetectorTags()
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Rule version: %s", input)
+		_, err := conn.UpdateRuleVersion(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Rule (%s) version: %w", d.Id(), err)
+		}
+
+		return resourceAwsFraudDetectorRuleRead(d, meta)
+	}
+
+	if d.HasChange("description") {
+		input := &frauddetector.UpdateRuleMetadataInput{
+			Description: aws.String(d.Get("description").(string)),
+			Rule:        rule,
+		}
+
+		log.Printf("[DEBUG] Updating Fraud Detector Rule metadata: %s", input)
+		_, err := conn.UpdateRuleMetadata(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Fraud Detector Rule (%s) metadata: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.FrauddetectorUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Fraud Detector Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFraudDetectorRuleRead(d, meta)
+}
+
+func resourceAwsFraudDetectorRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).frauddetectorconn
+
+	detectorID, ruleID, err := tffrauddetector.RuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	versions, err := finder.RuleVersionsByDetectorIDAndRuleID(conn, detectorID, ruleID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Fraud Detector Rule (%s): %w", d.Id(), err)
+	}
+
+	// Each rule version must be deleted individually.
+	for _, v := range versions {
+		log.Printf("[DEBUG] Deleting Fraud Detector Rule (%s) version: %s", d.Id(), aws.StringValue(v.RuleVersion))
+		_, err := conn.DeleteRule(&frauddetector.DeleteRuleInput{
+			Rule: &frauddetector.Rule{
+				DetectorId:  v.DetectorId,
+				RuleId:      v.RuleId,
+				RuleVersion: v.RuleVersion,
+			},
+		})
+
+		if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Fraud Detector Rule (%s) version (%s): %w", d.Id(), aws.StringValue(v.RuleVersion), err)
+		}
+	}
+
+	return nil
+}
+
+// fraudDetectorLatestRuleVersion returns the rule version with the highest version number.
+func fraudDetectorLatestRuleVersion(versions []*frauddetector.RuleDetail) *frauddetector.RuleDetail {
+	var latest *frauddetector.RuleDetail
+	var latestVersion int
+
+	for _, v := range versions {
+		version, err := strconv.Atoi(aws.StringValue(v.RuleVersion))
+
+		if err != nil {
+			continue
+		}
+
+		if latest == nil || version > latestVersion {
+			latest = v
+			latestVersion = version
+		}
+	}
+
+	if latest == nil {
+		return versions[0]
+	}
+
+	return latest
+}
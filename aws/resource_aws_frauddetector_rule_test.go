@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tffrauddetector "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorRule_basic(t *testing.T) {
+	var v frauddetector.RuleDetail
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorRuleConfig(rName, "description1", `$%[1]s_email == \"test@example.com\"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_frauddetector_detector.test", "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "language", frauddetector.LanguageDetectorpl),
+					resource.TestCheckResourceAttr(resourceName, "outcomes.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "outcomes.0", "aws_frauddetector_outcome.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "rule_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorRuleConfig(rName, "description2", `$%[1]s_email == \"test@example.com\"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSFraudDetectorRuleConfig(rName, "description2", `$%[1]s_email == \"other@example.com\"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorRule_disappears(t *testing.T) {
+	var v frauddetector.RuleDetail
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorRuleConfig(rName, "description1", `$%[1]s_email == \"test@example.com\"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorRuleExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFraudDetectorRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_rule" {
+			continue
+		}
+
+		detectorID, ruleID, err := tffrauddetector.RuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.RuleVersionsByDetectorIDAndRuleID(conn, detectorID, ruleID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorRuleExists(n string, v *frauddetector.RuleDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Rule ID is set")
+		}
+
+		detectorID, ruleID, err := tffrauddetector.RuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.RuleVersionsByDetectorIDAndRuleID(conn, detectorID, ruleID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *fraudDetectorLatestRuleVersion(output)
+
+		return nil
+	}
+}
+
+// testAccAWSFraudDetectorRuleConfig formats expression with rName so that it can reference the test variables.
+func testAccAWSFraudDetectorRuleConfig(rName, description, expression string) string {
+	return composeConfig(testAccAWSFraudDetectorDetectorConfig(rName), fmt.Sprintf(`
+resource "aws_frauddetector_rule" "test" {
+  detector_id = aws_frauddetector_detector.test.detector_id
+  rule_id     = %[1]q
+  description = %[2]q
+  expression  = "%[3]s"
+  outcomes    = [aws_frauddetector_outcome.test.name]
+
+  depends_on = [aws_frauddetector_variable.email]
+}
+`, rName, description, fmt.Sprintf(expression, rName)))
+}
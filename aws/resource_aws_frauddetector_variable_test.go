@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/frauddetector/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSFraudDetectorVariable_basic(t *testing.T) {
+	var v frauddetector.Variable
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_variable.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorVariableConfig(rName, "unknown"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorVariableExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "frauddetector", regexp.MustCompile(`variable/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_source", frauddetector.DataSourceEvent),
+					resource.TestCheckResourceAttr(resourceName, "data_type", frauddetector.DataTypeString),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "unknown"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "variable_type", "EMAIL_ADDRESS"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFraudDetectorVariableConfig(rName, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorVariableExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "none"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFraudDetectorVariable_disappears(t *testing.T) {
+	var v frauddetector.Variable
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(10))
+	resourceName := "aws_frauddetector_variable.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(frauddetector.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, frauddetector.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFraudDetectorVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFraudDetectorVariableConfig(rName, "unknown"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFraudDetectorVariableExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFraudDetectorVariable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFraudDetectorVariableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_frauddetector_variable" {
+			continue
+		}
+
+		_, err := finder.VariableByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Fraud Detector Variable %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFraudDetectorVariableExists(n string, v *frauddetector.Variable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Fraud Detector Variable ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).frauddetectorconn
+
+		output, err := finder.VariableByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFraudDetectorVariableConfig(rName, defaultValue string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_variable" "test" {
+  name          = %[1]q
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = %[2]q
+  variable_type = "EMAIL_ADDRESS"
+}
+`, rName, defaultValue)
+}
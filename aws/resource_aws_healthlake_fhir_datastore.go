@@ -0,0 +1,362 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsHealthLakeFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsHealthLakeFHIRDatastoreCreate,
+		Read:   resourceAwsHealthLakeFHIRDatastoreRead,
+		Update: resourceAwsHealthLakeFHIRDatastoreUpdate,
+		Delete: resourceAwsHealthLakeFHIRDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_type_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(healthlake.FHIRVersion_Values(), false),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`), ""),
+				),
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(healthlake.PreloadDataType_Values(), false),
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(healthlake.CmkType_Values(), false),
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 400),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsHealthLakeFHIRDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).healthlakeconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &healthlake.CreateFHIRDatastoreInput{
+		ClientToken:          aws.String(resource.UniqueId()),
+		DatastoreTypeVersion: aws.String(d.Get("datastore_type_version").(string)),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preload_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PreloadDataConfig = expandHealthLakePreloadDataConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfiguration = expandHealthLakeSseConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().HealthlakeTags()
+	}
+
+	log.Printf("[DEBUG] Creating HealthLake FHIR Data Store: %s", input)
+	output, err := conn.CreateFHIRDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating HealthLake FHIR Data Store: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.DatastoreId))
+
+	if _, err := waiter.FHIRDatastoreCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for HealthLake FHIR Data Store (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceAwsHealthLakeFHIRDatastoreRead(d, meta)
+}
+
+func resourceAwsHealthLakeFHIRDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).healthlakeconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	datastore, err := finder.FHIRDatastoreByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Data Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading HealthLake FHIR Data Store (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(datastore.DatastoreArn)
+	d.Set("arn", arn)
+	if datastore.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(datastore.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("datastore_type_version", datastore.DatastoreTypeVersion)
+	d.Set("endpoint", datastore.DatastoreEndpoint)
+	d.Set("name", datastore.DatastoreName)
+	d.Set("status", datastore.DatastoreStatus)
+
+	if datastore.PreloadDataConfig != nil {
+		if err := d.Set("preload_data_config", []interface{}{flattenHealthLakePreloadDataConfig(datastore.PreloadDataConfig)}); err != nil {
+			return fmt.Errorf("error setting preload_data_config: %w", err)
+		}
+	} else {
+		d.Set("preload_data_config", nil)
+	}
+
+	if datastore.SseConfiguration != nil {
+		if err := d.Set("sse_configuration", []interface{}{flattenHealthLakeSseConfiguration(datastore.SseConfiguration)}); err != nil {
+			return fmt.Errorf("error setting sse_configuration: %w", err)
+		}
+	} else {
+		d.Set("sse_configuration", nil)
+	}
+
+	tags, err := keyvaluetags.HealthlakeListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for HealthLake FHIR Data Store (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsHealthLakeFHIRDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).healthlakeconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.HealthlakeUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating HealthLake FHIR Data Store (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsHealthLakeFHIRDatastoreRead(d, meta)
+}
+
+func resourceAwsHealthLakeFHIRDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).healthlakeconn
+
+	log.Printf("[DEBUG] Deleting HealthLake FHIR Data Store: %s", d.Id())
+	_, err := conn.DeleteFHIRDatastore(&healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting HealthLake FHIR Data Store (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.FHIRDatastoreDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for HealthLake FHIR Data Store (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandHealthLakePreloadDataConfig(tfMap map[string]interface{}) *healthlake.PreloadDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.PreloadDataConfig{}
+
+	if v, ok := tfMap["preload_data_type"].(string); ok && v != "" {
+		apiObject.PreloadDataType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHealthLakeSseConfiguration(tfMap map[string]interface{}) *healthlake.SseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KmsEncryptionConfig = expandHealthLakeKmsEncryptionConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandHealthLakeKmsEncryptionConfig(tfMap map[string]interface{}) *healthlake.KmsEncryptionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.KmsEncryptionConfig{}
+
+	if v, ok := tfMap["cmk_type"].(string); ok && v != "" {
+		apiObject.CmkType = aws.String(v)
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenHealthLakePreloadDataConfig(apiObject *healthlake.PreloadDataConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PreloadDataType; v != nil {
+		tfMap["preload_data_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenHealthLakeSseConfiguration(apiObject *healthlake.SseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		tfMap["kms_encryption_config"] = []interface{}{flattenHealthLakeKmsEncryptionConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHealthLakeKmsEncryptionConfig(apiObject *healthlake.KmsEncryptionConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CmkType; v != nil {
+		tfMap["cmk_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KmsKeyId; v != nil {
+		tfMap["kms_key_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
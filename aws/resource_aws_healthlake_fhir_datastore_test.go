@@ -0,0 +1,357 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_healthlake_fhir_datastore", &resource.Sweeper{
+		Name: "aws_healthlake_fhir_datastore",
+		F:    testSweepHealthLakeFHIRDatastores,
+	})
+}
+
+func testSweepHealthLakeFHIRDatastores(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*AWSClient).healthlakeconn
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &healthlake.ListFHIRDatastoresInput{
+		Filter: &healthlake.DatastoreFilter{
+			DatastoreStatus: aws.String(healthlake.DatastoreStatusActive),
+		},
+	}
+
+	err = conn.ListFHIRDatastoresPages(input, func(page *healthlake.ListFHIRDatastoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, datastore := range page.DatastorePropertiesList {
+			if datastore == nil {
+				continue
+			}
+
+			r := resourceAwsHealthLakeFHIRDatastore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(datastore.DatastoreId))
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing HealthLake FHIR Data Stores: %w", err))
+	}
+
+	if err = testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping HealthLake FHIR Data Stores for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping HealthLake FHIR Data Stores sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSHealthLakeFHIRDatastore_basic(t *testing.T) {
+	var datastore healthlake.DatastoreProperties
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(healthlake.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, healthlake.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSHealthLakeFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSHealthLakeFHIRDatastoreConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRDatastoreExists(resourceName, &datastore),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "healthlake", regexp.MustCompile(`datastore/fhir/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_type_version", healthlake.FHIRVersionR4),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", healthlake.DatastoreStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSHealthLakeFHIRDatastore_disappears(t *testing.T) {
+	var datastore healthlake.DatastoreProperties
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(healthlake.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, healthlake.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSHealthLakeFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSHealthLakeFHIRDatastoreConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRDatastoreExists(resourceName, &datastore),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsHealthLakeFHIRDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSHealthLakeFHIRDatastore_PreloadDataConfig(t *testing.T) {
+	var datastore healthlake.DatastoreProperties
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(healthlake.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, healthlake.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSHealthLakeFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSHealthLakeFHIRDatastoreConfigPreloadDataConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRDatastoreExists(resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.0.preload_data_type", healthlake.PreloadDataTypeSynthea),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSHealthLakeFHIRDatastore_SseConfiguration(t *testing.T) {
+	var datastore healthlake.DatastoreProperties
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_healthlake_fhir_datastore.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(healthlake.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, healthlake.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSHealthLakeFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSHealthLakeFHIRDatastoreConfigSseConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRDatastoreExists(resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.0.cmk_type", healthlake.CmkTypeCustomerManagedKmsKey),
+					resource.TestCheckResourceAttrPair(resourceName, "sse_configuration.0.kms_encryption_config.0.kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSHealthLakeFHIRDatastore_tags(t *testing.T) {
+	var datastore healthlake.DatastoreProperties
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(healthlake.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, healthlake.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSHealthLakeFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSHealthLakeFHIRDatastoreConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRDatastoreExists(resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSHealthLakeFHIRDatastoreConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRDatastoreExists(resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSHealthLakeFHIRDatastoreConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRDatastoreExists(resourceName, &datastore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSHealthLakeFHIRDatastoreDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).healthlakeconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_healthlake_fhir_datastore" {
+			continue
+		}
+
+		_, err := finder.FHIRDatastoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("HealthLake FHIR Data Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSHealthLakeFHIRDatastoreExists(n string, v *healthlake.DatastoreProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HealthLake FHIR Data Store ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).healthlakeconn
+
+		output, err := finder.FHIRDatastoreByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSHealthLakeFHIRDatastoreConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+}
+`, rName)
+}
+
+func testAccAWSHealthLakeFHIRDatastoreConfigPreloadDataConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+
+  preload_data_config {
+    preload_data_type = "SYNTHEA"
+  }
+}
+`, rName)
+}
+
+func testAccAWSHealthLakeFHIRDatastoreConfigSseConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+
+  sse_configuration {
+    kms_encryption_config {
+      cmk_type   = "CUSTOMER_MANAGED_KMS_KEY"
+      kms_key_id = aws_kms_key.test.arn
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSHealthLakeFHIRDatastoreConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSHealthLakeFHIRDatastoreConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
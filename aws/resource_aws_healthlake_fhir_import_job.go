@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfhealthlake "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsHealthLakeFHIRImportJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsHealthLakeFHIRImportJobCreate,
+		Read:   resourceAwsHealthLakeFHIRImportJobRead,
+		Delete: resourceAwsHealthLakeFHIRImportJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"datastore_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"input_s3_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"job_output_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 400),
+									},
+									"s3_uri": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"submit_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsHealthLakeFHIRImportJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).healthlakeconn
+
+	datastoreID := d.Get("datastore_id").(string)
+	input := &healthlake.StartFHIRImportJobInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		DataAccessRoleArn: aws.String(d.Get("data_access_role_arn").(string)),
+		DatastoreId:       aws.String(datastoreID),
+		InputDataConfig: &healthlake.InputDataConfig{
+			S3Uri: aws.String(d.Get("input_s3_uri").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("job_name"); ok {
+		input.JobName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_output_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobOutputDataConfig = expandHealthLakeOutputDataConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting HealthLake FHIR Import Job: %s", input)
+	output, err := conn.StartFHIRImportJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting HealthLake FHIR Import Job (%s): %w", datastoreID, err)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+	d.SetId(tfhealthlake.FHIRImportJobCreateResourceID(datastoreID, jobID))
+
+	if _, err := waiter.FHIRImportJobCompleted(conn, datastoreID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for HealthLake FHIR Import Job (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceAwsHealthLakeFHIRImportJobRead(d, meta)
+}
+
+func resourceAwsHealthLakeFHIRImportJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).healthlakeconn
+
+	datastoreID, jobID, err := tfhealthlake.FHIRImportJobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := finder.FHIRImportJobByDatastoreIDAndJobID(conn, datastoreID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Import Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading HealthLake FHIR Import Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("data_access_role_arn", job.DataAccessRoleArn)
+	d.Set("datastore_id", job.DatastoreId)
+	if job.InputDataConfig != nil {
+		d.Set("input_s3_uri", job.InputDataConfig.S3Uri)
+	} else {
+		d.Set("input_s3_uri", nil)
+	}
+	d.Set("job_id", job.JobId)
+	d.Set("job_name", job.JobName)
+	d.Set("status", job.JobStatus)
+	if job.SubmitTime != nil {
+		d.Set("submit_time", aws.TimeValue(job.SubmitTime).Format(time.RFC3339))
+	} else {
+		d.Set("submit_time", nil)
+	}
+
+	if job.JobOutputDataConfig != nil {
+		if err := d.Set("job_output_data_config", []interface{}{flattenHealthLakeOutputDataConfig(job.JobOutputDataConfig)}); err != nil {
+			return fmt.Errorf("error setting job_output_data_config: %w", err)
+		}
+	} else {
+		d.Set("job_output_data_config", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsHealthLakeFHIRImportJobDelete(d *schema.ResourceData, meta interface{}) error {
+	// FHIR import jobs cannot be deleted, only removed from state.
+	log.Printf("[WARN] HealthLake FHIR Import Job (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func expandHealthLakeOutputDataConfig(tfMap map[string]interface{}) *healthlake.OutputDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.OutputDataConfig{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Configuration = expandHealthLakeS3Configuration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandHealthLakeS3Configuration(tfMap map[string]interface{}) *healthlake.S3Configuration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.S3Configuration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenHealthLakeOutputDataConfig(apiObject *healthlake.OutputDataConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Configuration; v != nil {
+		tfMap["s3_configuration"] = []interface{}{flattenHealthLakeS3Configuration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenHealthLakeS3Configuration(apiObject *healthlake.S3Configuration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsKeyId; v != nil {
+		tfMap["kms_key_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.S3Uri; v != nil {
+		tfMap["s3_uri"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfhealthlake "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/healthlake/finder"
+)
+
+func TestAccAWSHealthLakeFHIRImportJob_basic(t *testing.T) {
+	var job healthlake.ImportJobProperties
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_healthlake_fhir_import_job.test"
+	datastoreResourceName := "aws_healthlake_fhir_datastore.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(healthlake.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, healthlake.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSHealthLakeFHIRDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSHealthLakeFHIRImportJobConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSHealthLakeFHIRImportJobExists(resourceName, &job),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_id", datastoreResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_output_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", healthlake.JobStatusCompleted),
+					resource.TestCheckResourceAttrSet(resourceName, "submit_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSHealthLakeFHIRImportJobExists(n string, v *healthlake.ImportJobProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No HealthLake FHIR Import Job ID is set")
+		}
+
+		datastoreID, jobID, err := tfhealthlake.FHIRImportJobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).healthlakeconn
+
+		output, err := finder.FHIRImportJobByDatastoreIDAndJobID(conn, datastoreID, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSHealthLakeFHIRImportJobConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "input/patient.ndjson"
+  content = jsonencode({ resourceType = "Patient", id = "tf-acc-test", active = true })
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "healthlake.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:ListBucket", "s3:PutObject", "s3:GetBucketPublicAccessBlock", "s3:GetEncryptionConfiguration"]
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }, {
+      Effect   = "Allow"
+      Action   = ["kms:DescribeKey", "kms:GenerateDataKey", "kms:Decrypt"]
+      Resource = [aws_kms_key.test.arn]
+    }]
+  })
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+}
+
+resource "aws_healthlake_fhir_import_job" "test" {
+  datastore_id         = aws_healthlake_fhir_datastore.test.id
+  data_access_role_arn = aws_iam_role.test.arn
+  input_s3_uri         = "s3://${aws_s3_bucket.test.id}/input/"
+  job_name             = %[1]q
+
+  job_output_data_config {
+    s3_configuration {
+      kms_key_id = aws_kms_key.test.arn
+      s3_uri     = "s3://${aws_s3_bucket.test.id}/output/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_bucket_object.test]
+}
+`, rName)
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIamRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamRolePoliciesExclusivePut,
+		Read:   resourceAwsIamRolePoliciesExclusiveRead,
+		Update: resourceAwsIamRolePoliciesExclusivePut,
+		Delete: resourceAwsIamRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamRolePoliciesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	roleName := d.Get("role_name").(string)
+	want := d.Get("policy_names").(*schema.Set)
+
+	have, err := finder.RolePolicyNames(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	for _, policyName := range have {
+		if want.Contains(policyName) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy not in exclusive set: %s", roleName, policyName)
+		_, err := conn.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(policyName),
+			RoleName:   aws.String(roleName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting IAM Role (%s) inline policy (%s): %w", roleName, policyName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceAwsIamRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceAwsIamRolePoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	policyNames, err := finder.RolePolicyNames(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing inline policies exclusive management from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", d.Id(), err)
+	}
+
+	if err := d.Set("policy_names", policyNames); err != nil {
+		return fmt.Errorf("error setting policy_names: %w", err)
+	}
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceAwsIamRolePoliciesExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Removing exclusive management leaves the role's inline policies in place.
+	log.Printf("[DEBUG] Removing IAM Role (%s) inline policies exclusive management from state", d.Id())
+
+	return nil
+}
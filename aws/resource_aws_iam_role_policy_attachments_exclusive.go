@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsIamRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamRolePolicyAttachmentsExclusivePut,
+		Read:   resourceAwsIamRolePolicyAttachmentsExclusiveRead,
+		Update: resourceAwsIamRolePolicyAttachmentsExclusivePut,
+		Delete: resourceAwsIamRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamRolePolicyAttachmentsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	roleName := d.Get("role_name").(string)
+	want := d.Get("policy_arns").(*schema.Set)
+
+	have, err := finder.RoleAttachedPolicyARNs(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) policy attachments: %w", roleName, err)
+	}
+
+	attached := make(map[string]bool, len(have))
+
+	for _, policyARN := range have {
+		attached[policyARN] = true
+
+		if want.Contains(policyARN) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Detaching IAM Role (%s) policy not in exclusive set: %s", roleName, policyARN)
+		err := detachPolicyFromRole(conn, roleName, policyARN)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error detaching IAM Role (%s) policy (%s): %w", roleName, policyARN, err)
+		}
+	}
+
+	for _, v := range want.List() {
+		policyARN := v.(string)
+
+		if attached[policyARN] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Attaching IAM Role (%s) policy: %s", roleName, policyARN)
+		if err := attachPolicyToRole(conn, roleName, policyARN); err != nil {
+			return fmt.Errorf("error attaching IAM Role (%s) policy (%s): %w", roleName, policyARN, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceAwsIamRolePolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceAwsIamRolePolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	policyARNs, err := finder.RoleAttachedPolicyARNs(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing policy attachments exclusive management from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) policy attachments: %w", d.Id(), err)
+	}
+
+	if err := d.Set("policy_arns", policyARNs); err != nil {
+		return fmt.Errorf("error setting policy_arns: %w", err)
+	}
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceAwsIamRolePolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Removing exclusive management leaves the role's policy attachments in place.
+	log.Printf("[DEBUG] Removing IAM Role (%s) policy attachments exclusive management from state", d.Id())
+
+	return nil
+}
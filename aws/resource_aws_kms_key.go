@@ -1,12 +1,16 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -29,7 +33,10 @@ func resourceAwsKmsKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsKmsKeyCustomizeDiffPrimaryRegion,
+			SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -89,6 +96,13 @@ func resourceAwsKmsKey() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(kms.KeyUsageType_Values(), false),
 			},
 
+			"multi_region": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -97,12 +111,35 @@ func resourceAwsKmsKey() *schema.Resource {
 				ValidateFunc:     validation.StringIsJSON,
 			},
 
+			"primary_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
+func resourceAwsKmsKeyCustomizeDiffPrimaryRegion(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// New and replaced keys are checked in Create.
+	if diff.Id() == "" || diff.HasChange("multi_region") || !diff.HasChange("primary_region") {
+		return nil
+	}
+
+	if !diff.Get("multi_region").(bool) {
+		return fmt.Errorf("primary_region can only be changed for multi-Region keys")
+	}
+
+	if o, _ := diff.GetChange("primary_region"); o.(string) == "" {
+		return fmt.Errorf("primary_region cannot be changed until the current primary region is known")
+	}
+
+	return nil
+}
+
 func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
@@ -118,6 +155,21 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("multi_region"); ok {
+		input.MultiRegion = aws.Bool(v.(bool))
+	}
+
+	// The primary region can only be moved to a region that already has a replica.
+	if v, ok := d.GetOk("primary_region"); ok {
+		if !d.Get("multi_region").(bool) {
+			return fmt.Errorf("primary_region can only be set for multi-Region keys")
+		}
+
+		if region := meta.(*AWSClient).region; v.(string) != region {
+			return fmt.Errorf("primary_region (%s) must be the provider region (%s) when creating a KMS Key", v.(string), region)
+		}
+	}
+
 	if v, ok := d.GetOk("policy"); ok {
 		input.Policy = aws.String(v.(string))
 	}
@@ -195,7 +247,13 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("is_enabled", key.metadata.Enabled)
 	d.Set("key_id", key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
+	d.Set("multi_region", key.metadata.MultiRegion)
 	d.Set("policy", key.policy)
+	if v := key.metadata.MultiRegionConfiguration; v != nil && v.PrimaryKey != nil {
+		d.Set("primary_region", v.PrimaryKey.Region)
+	} else {
+		d.Set("primary_region", nil)
+	}
 
 	tags := key.tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
 
@@ -239,6 +297,14 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("primary_region") {
+		o, n := d.GetChange("primary_region")
+
+		if err := updateKmsKeyPrimaryRegion(conn, d.Get("arn").(string), o.(string), n.(string)); err != nil {
+			return err
+		}
+	}
+
 	if hasChange, enabled := d.HasChange("is_enabled"), d.Get("is_enabled").(bool); hasChange && !enabled {
 		// Only disable after all attributes have been modified because we cannot modify disabled keys.
 		if err := updateKmsKeyEnabled(conn, d.Id(), enabled); err != nil {
@@ -449,6 +515,45 @@ func updateKmsKeyPolicy(conn *kms.KMS, keyID string, policy string, bypassPolicy
 	return nil
 }
 
+// updateKmsKeyPrimaryRegion makes the multi-Region key's replica in newRegion the primary key.
+// The key in the current primary region becomes a replica.
+func updateKmsKeyPrimaryRegion(conn *kms.KMS, keyARN, oldRegion, newRegion string) error {
+	parsedARN, err := arn.Parse(keyARN)
+
+	if err != nil {
+		return fmt.Errorf("error parsing KMS Key ARN (%s): %w", keyARN, err)
+	}
+
+	// The primary region is updated from the current primary key's region.
+	parsedARN.Region = oldRegion
+	primaryKeyARN := parsedARN.String()
+
+	// Only the region is overridden, so any configured kms endpoint is kept.
+	sess, err := session.NewSession(conn.Config.Copy(&aws.Config{
+		Region: aws.String(oldRegion),
+	}))
+
+	if err != nil {
+		return fmt.Errorf("error creating AWS session for region (%s): %w", oldRegion, err)
+	}
+
+	input := &kms.UpdatePrimaryRegionInput{
+		KeyId:         aws.String(primaryKeyARN),
+		PrimaryRegion: aws.String(newRegion),
+	}
+
+	log.Printf("[DEBUG] Updating KMS Key (%s) primary region: %s", primaryKeyARN, input)
+	if _, err := kms.New(sess).UpdatePrimaryRegion(input); err != nil {
+		return fmt.Errorf("error updating KMS Key (%s) primary region (%s): %w", primaryKeyARN, newRegion, err)
+	}
+
+	if err := waiter.KeyPrimaryRegionUpdated(conn, keyARN, newRegion); err != nil {
+		return fmt.Errorf("error waiting for KMS Key (%s) primary region update: %w", keyARN, err)
+	}
+
+	return nil
+}
+
 func updateKmsKeyRotationEnabled(conn *kms.KMS, keyID string, enabled bool) error {
 	updateFunc := func() (interface{}, error) {
 		var err error
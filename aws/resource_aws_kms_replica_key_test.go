@@ -0,0 +1,320 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kms/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSKmsReplicaKey_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_replica_key.test"
+	primaryKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSKmsReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsReplicaKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kms", regexp.MustCompile(`key/mrk-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_rotation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_spec", "SYMMETRIC_DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "ENCRYPT_DECRYPT"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config:                  testAccAWSKmsReplicaKeyConfig(rName),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsReplicaKey_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSKmsReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsReplicaKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsKmsReplicaKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsReplicaKey_Enabled(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSKmsReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsReplicaKeyConfigEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSKmsReplicaKeyConfigEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsReplicaKey_Tags(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSKmsReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsReplicaKeyConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSKmsReplicaKeyConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSKmsReplicaKeyConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsReplicaKey_PrimaryRegion(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_replica_key.test"
+	primaryKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:        testAccErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAWSKmsReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsReplicaKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(primaryKeyResourceName, "primary_region", testAccGetAlternateRegion()),
+				),
+			},
+			{
+				Config: testAccAWSKmsReplicaKeyConfigPrimaryRegion(rName, testAccGetRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(primaryKeyResourceName, "primary_region", testAccGetRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAWSKmsReplicaKeyConfigPrimaryRegion(rName, testAccGetAlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(primaryKeyResourceName, "primary_region", testAccGetAlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKmsReplicaKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_replica_key" {
+			continue
+		}
+
+		_, err := finder.KeyByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Replica Key %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSKmsReplicaKeyConfigBase(rName string) string {
+	return composeConfig(
+		testAccAlternateRegionProviderConfig(),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  provider = "awsalternate"
+
+  description             = %[1]q
+  deletion_window_in_days = 7
+  multi_region            = true
+}
+`, rName))
+}
+
+func testAccAWSKmsReplicaKeyConfig(rName string) string {
+	return composeConfig(
+		testAccAWSKmsReplicaKeyConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kms_replica_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  primary_key_arn         = aws_kms_key.test.arn
+}
+`, rName))
+}
+
+func testAccAWSKmsReplicaKeyConfigPrimaryRegion(rName, primaryRegion string) string {
+	return composeConfig(
+		testAccAlternateRegionProviderConfig(),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  provider = "awsalternate"
+
+  description             = %[1]q
+  deletion_window_in_days = 7
+  multi_region            = true
+  primary_region          = %[2]q
+}
+
+resource "aws_kms_replica_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  primary_key_arn         = aws_kms_key.test.arn
+}
+`, rName, primaryRegion))
+}
+
+func testAccAWSKmsReplicaKeyConfigEnabled(rName string, enabled bool) string {
+	return composeConfig(
+		testAccAWSKmsReplicaKeyConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kms_replica_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enabled                 = %[2]t
+  primary_key_arn         = aws_kms_key.test.arn
+}
+`, rName, enabled))
+}
+
+func testAccAWSKmsReplicaKeyConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSKmsReplicaKeyConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kms_replica_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  primary_key_arn         = aws_kms_key.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSKmsReplicaKeyConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSKmsReplicaKeyConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kms_replica_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  primary_key_arn         = aws_kms_key.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,746 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	// Number of entries sent in each BatchGrantPermissions / BatchRevokePermissions request.
+	lakeFormationPermissionsBatchSize = 20
+)
+
+func resourceAwsLakeFormationPermissionsBatch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsBatchCreate,
+		Read:   resourceAwsLakeFormationPermissionsBatchRead,
+		Update: resourceAwsLakeFormationPermissionsBatchUpdate,
+		Delete: resourceAwsLakeFormationPermissionsBatchDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"entry": {
+				Type:     schema.TypeSet,
+				MinItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_location": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+								},
+							},
+						},
+						"database": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"lf_tag_policy": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"expression": {
+										Type:     schema.TypeSet,
+										MinItems: 1,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													MinItems: 1,
+													Required: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringLenBetween(1, 256),
+													},
+												},
+											},
+										},
+									},
+									"resource_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(lakeformation.ResourceType_Values(), false),
+									},
+								},
+							},
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validatePrincipal,
+						},
+						"table": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Default:  false,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationPermissionsBatchCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	entries, err := expandLakeFormationBatchPermissionsRequestEntries(d.Get("entry").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	if err := grantLakeFormationBatchPermissions(conn, d.Get("catalog_id").(string), entries); err != nil {
+		return fmt.Errorf("error creating Lake Formation Permissions (batch): %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s%s", d.Get("catalog_id").(string), entries))))
+
+	return resourceAwsLakeFormationPermissionsBatchRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsBatchRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	tfList := d.Get("entry").(*schema.Set).List()
+	var found []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		entry, err := expandLakeFormationBatchPermissionsRequestEntry("", tfMap)
+
+		if err != nil {
+			return err
+		}
+
+		input := &lakeformation.ListPermissionsInput{
+			Principal: entry.Principal,
+			Resource:  entry.Resource,
+		}
+
+		if v, ok := d.GetOk("catalog_id"); ok {
+			input.CatalogId = aws.String(v.(string))
+		}
+
+		permissions, err := finder.Permissions(conn, input)
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) || tfawserr.ErrMessageContains(err, "AccessDeniedException", "Resource does not exist") {
+			log.Printf("[WARN] Lake Formation Permissions (batch) (%s) entry not found: %s", d.Id(), err)
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Lake Formation Permissions (batch) (%s): %w", d.Id(), err)
+		}
+
+		// LF-Tag policy permissions are returned as-is; everything else needs the same filtering as aws_lakeformation_permissions.
+		if input.Resource.LFTagPolicy == nil {
+			permissions = tflakeformation.FilterPermissions(input, "", nil, nil, false, permissions)
+		}
+
+		if len(permissions) == 0 {
+			log.Printf("[WARN] Lake Formation Permissions (batch) (%s) entry for principal (%s) not found", d.Id(), aws.StringValue(entry.Principal.DataLakePrincipalIdentifier))
+			continue
+		}
+
+		// Revoked or changed permissions show up as drift by dropping the entry.
+		if !lakeFormationBatchPermissionsEntryMatches(tfMap, permissions) {
+			log.Printf("[WARN] Lake Formation Permissions (batch) (%s) entry for principal (%s) has different permissions", d.Id(), aws.StringValue(entry.Principal.DataLakePrincipalIdentifier))
+			continue
+		}
+
+		found = append(found, tfMap)
+	}
+
+	if !d.IsNewResource() && len(found) == 0 {
+		log.Printf("[WARN] Lake Formation Permissions (batch) (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if d.IsNewResource() {
+		// Newly granted permissions may not be visible yet.
+		found = tfList
+	}
+
+	if err := d.Set("entry", found); err != nil {
+		return fmt.Errorf("error setting entry: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsBatchUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	if d.HasChange("entry") {
+		o, n := d.GetChange("entry")
+		oldEntries, newEntries := o.(*schema.Set), n.(*schema.Set)
+
+		// Grants are added before anything is revoked, and only permissions that are no longer
+		// configured are revoked, so unchanged grants stay in effect throughout the update.
+		add, err := expandLakeFormationBatchPermissionsRequestEntries(newEntries.Difference(oldEntries).List())
+
+		if err != nil {
+			return err
+		}
+
+		if err := grantLakeFormationBatchPermissions(conn, d.Get("catalog_id").(string), add); err != nil {
+			return fmt.Errorf("error updating Lake Formation Permissions (batch) (%s): %w", d.Id(), err)
+		}
+
+		del, err := lakeFormationBatchPermissionsRevokeEntries(oldEntries.Difference(newEntries).List(), newEntries.List())
+
+		if err != nil {
+			return err
+		}
+
+		if err := revokeLakeFormationBatchPermissions(conn, d.Get("catalog_id").(string), del); err != nil {
+			return fmt.Errorf("error updating Lake Formation Permissions (batch) (%s): %w", d.Id(), err)
+		}
+	}
+
+	// Newly granted permissions may not be visible yet, so the configured entries are kept as-is.
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsBatchDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	entries, err := expandLakeFormationBatchPermissionsRequestEntries(d.Get("entry").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	if err := revokeLakeFormationBatchPermissions(conn, d.Get("catalog_id").(string), entries); err != nil {
+		return fmt.Errorf("error deleting Lake Formation Permissions (batch) (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// lakeFormationBatchPermissionsEntryMatches returns whether the permissions granted match the entry's
+// permissions and permissions_with_grant_option.
+func lakeFormationBatchPermissionsEntryMatches(tfMap map[string]interface{}, apiObjects []*lakeformation.PrincipalResourcePermissions) bool {
+	var grantPermissions []interface{}
+
+	if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok {
+		grantPermissions = v.List()
+	}
+
+	permissionsMatch := schema.NewSet(schema.HashString, tfMap["permissions"].(*schema.Set).List()).Equal(
+		schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(flattenLakeFormationPermissions(apiObjects)))),
+	)
+	grantPermissionsMatch := schema.NewSet(schema.HashString, grantPermissions).Equal(
+		schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(flattenLakeFormationGrantPermissions(apiObjects)))),
+	)
+
+	return permissionsMatch && grantPermissionsMatch
+}
+
+// grantLakeFormationBatchPermissions grants the entries in batches, retrying entries that fail while IAM changes propagate.
+func grantLakeFormationBatchPermissions(conn *lakeformation.LakeFormation, catalogID string, entries []*lakeformation.BatchPermissionsRequestEntry) error {
+	for _, chunk := range chunkLakeFormationBatchPermissionsRequestEntries(entries) {
+		input := &lakeformation.BatchGrantPermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		log.Printf("[DEBUG] Granting Lake Formation Permissions in batch: %s", input)
+		err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+			output, err := conn.BatchGrantPermissions(input)
+
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if output == nil || len(output.Failures) == 0 {
+				return nil
+			}
+
+			err = lakeFormationBatchPermissionsFailuresError(output.Failures)
+
+			if lakeFormationBatchGrantPermissionsFailuresRetryable(output.Failures) {
+				// Retry only the entries that failed.
+				input.Entries = lakeFormationBatchPermissionsFailedEntries(output.Failures)
+
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		})
+
+		if tfresource.TimedOut(err) {
+			var output *lakeformation.BatchGrantPermissionsOutput
+			output, err = conn.BatchGrantPermissions(input)
+
+			if err == nil && output != nil && len(output.Failures) > 0 {
+				err = lakeFormationBatchPermissionsFailuresError(output.Failures)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revokeLakeFormationBatchPermissions revokes the entries in batches. Entries whose permissions are already revoked are ignored.
+func revokeLakeFormationBatchPermissions(conn *lakeformation.LakeFormation, catalogID string, entries []*lakeformation.BatchPermissionsRequestEntry) error {
+	for _, chunk := range chunkLakeFormationBatchPermissionsRequestEntries(entries) {
+		input := &lakeformation.BatchRevokePermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		log.Printf("[DEBUG] Revoking Lake Formation Permissions in batch: %s", input)
+		err := resource.Retry(waiter.PermissionsDeleteRetryTimeout, func() *resource.RetryError {
+			output, err := conn.BatchRevokePermissions(input)
+
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if output == nil {
+				return nil
+			}
+
+			var failures []*lakeformation.BatchPermissionsFailureEntry
+
+			for _, failure := range output.Failures {
+				if failure == nil || failure.Error == nil {
+					continue
+				}
+
+				if tfawserr.ErrMessageContains(awserrFromLakeFormationErrorDetail(failure.Error), lakeformation.ErrCodeInvalidInputException, "No permissions revoked") {
+					continue
+				}
+
+				failures = append(failures, failure)
+			}
+
+			if len(failures) == 0 {
+				return nil
+			}
+
+			input.Entries = lakeFormationBatchPermissionsFailedEntries(failures)
+
+			return resource.RetryableError(lakeFormationBatchPermissionsFailuresError(failures))
+		})
+
+		if tfresource.TimedOut(err) {
+			var output *lakeformation.BatchRevokePermissionsOutput
+			output, err = conn.BatchRevokePermissions(input)
+
+			if err == nil && output != nil && len(output.Failures) > 0 {
+				err = lakeFormationBatchPermissionsFailuresError(output.Failures)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lakeFormationBatchPermissionsRevokeEntries returns the entries to revoke when the removed entries are
+// replaced by the remaining ones. Permissions still granted by a remaining entry for the same principal
+// and resource are not revoked.
+func lakeFormationBatchPermissionsRevokeEntries(removed, remaining []interface{}) ([]*lakeformation.BatchPermissionsRequestEntry, error) {
+	kept := make(map[string]*lakeformation.BatchPermissionsRequestEntry)
+
+	for _, tfMapRaw := range remaining {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		entry, err := expandLakeFormationBatchPermissionsRequestEntry("", tfMap)
+
+		if err != nil {
+			return nil, err
+		}
+
+		key := lakeFormationBatchPermissionsEntryKey(entry)
+
+		if v, ok := kept[key]; ok {
+			v.Permissions = append(v.Permissions, entry.Permissions...)
+			v.PermissionsWithGrantOption = append(v.PermissionsWithGrantOption, entry.PermissionsWithGrantOption...)
+			continue
+		}
+
+		kept[key] = entry
+	}
+
+	var apiObjects []*lakeformation.BatchPermissionsRequestEntry
+
+	for _, tfMapRaw := range removed {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		entry, err := expandLakeFormationBatchPermissionsRequestEntry("", tfMap)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := kept[lakeFormationBatchPermissionsEntryKey(entry)]; ok {
+			entry.Permissions = lakeFormationPermissionsDifference(entry.Permissions, v.Permissions)
+			entry.PermissionsWithGrantOption = lakeFormationPermissionsDifference(entry.PermissionsWithGrantOption, v.PermissionsWithGrantOption)
+		}
+
+		if len(entry.Permissions) == 0 && len(entry.PermissionsWithGrantOption) == 0 {
+			continue
+		}
+
+		entry.Id = aws.String(strconv.Itoa(len(apiObjects)))
+		apiObjects = append(apiObjects, entry)
+	}
+
+	return apiObjects, nil
+}
+
+// lakeFormationBatchPermissionsEntryKey identifies an entry by its principal and resource.
+func lakeFormationBatchPermissionsEntryKey(apiObject *lakeformation.BatchPermissionsRequestEntry) string {
+	return (&lakeformation.BatchPermissionsRequestEntry{
+		Principal: apiObject.Principal,
+		Resource:  apiObject.Resource,
+	}).String()
+}
+
+// lakeFormationPermissionsDifference returns the permissions in s1 that are not in s2.
+func lakeFormationPermissionsDifference(s1, s2 []*string) []*string {
+	var result []*string
+
+	for _, v1 := range s1 {
+		found := false
+
+		for _, v2 := range s2 {
+			if aws.StringValue(v1) == aws.StringValue(v2) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, v1)
+		}
+	}
+
+	return result
+}
+
+func chunkLakeFormationBatchPermissionsRequestEntries(entries []*lakeformation.BatchPermissionsRequestEntry) [][]*lakeformation.BatchPermissionsRequestEntry {
+	var chunks [][]*lakeformation.BatchPermissionsRequestEntry
+
+	for i := 0; i < len(entries); i += lakeFormationPermissionsBatchSize {
+		end := i + lakeFormationPermissionsBatchSize
+
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		chunks = append(chunks, entries[i:end])
+	}
+
+	return chunks
+}
+
+func lakeFormationBatchGrantPermissionsFailuresRetryable(failures []*lakeformation.BatchPermissionsFailureEntry) bool {
+	for _, failure := range failures {
+		if failure == nil || failure.Error == nil {
+			continue
+		}
+
+		err := awserrFromLakeFormationErrorDetail(failure.Error)
+
+		switch {
+		case tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal"),
+			tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "Grantee has no permissions"),
+			tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "register the S3 path"),
+			tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException),
+			tfawserr.ErrMessageContains(err, "AccessDeniedException", "is not authorized to access requested permissions"):
+			continue
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func lakeFormationBatchPermissionsFailedEntries(failures []*lakeformation.BatchPermissionsFailureEntry) []*lakeformation.BatchPermissionsRequestEntry {
+	var entries []*lakeformation.BatchPermissionsRequestEntry
+
+	for _, failure := range failures {
+		if failure == nil || failure.RequestEntry == nil {
+			continue
+		}
+
+		entries = append(entries, failure.RequestEntry)
+	}
+
+	return entries
+}
+
+func lakeFormationBatchPermissionsFailuresError(failures []*lakeformation.BatchPermissionsFailureEntry) error {
+	var errs *multierror.Error
+
+	for _, failure := range failures {
+		if failure == nil || failure.Error == nil {
+			continue
+		}
+
+		var principal string
+
+		if failure.RequestEntry != nil && failure.RequestEntry.Principal != nil {
+			principal = aws.StringValue(failure.RequestEntry.Principal.DataLakePrincipalIdentifier)
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("principal (%s): %w", principal, awserrFromLakeFormationErrorDetail(failure.Error)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func awserrFromLakeFormationErrorDetail(apiObject *lakeformation.ErrorDetail) error {
+	return awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage), nil)
+}
+
+func expandLakeFormationBatchPermissionsRequestEntries(tfList []interface{}) ([]*lakeformation.BatchPermissionsRequestEntry, error) {
+	var apiObjects []*lakeformation.BatchPermissionsRequestEntry
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject, err := expandLakeFormationBatchPermissionsRequestEntry(strconv.Itoa(i), tfMap)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandLakeFormationBatchPermissionsRequestEntry(id string, tfMap map[string]interface{}) (*lakeformation.BatchPermissionsRequestEntry, error) {
+	apiObject := &lakeformation.BatchPermissionsRequestEntry{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(tfMap["principal"].(string)),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	if id != "" {
+		apiObject.Id = aws.String(id)
+	}
+
+	if v, ok := tfMap["permissions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Permissions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PermissionsWithGrantOption = expandStringSet(v)
+	}
+
+	resources := 0
+
+	if v, ok := tfMap["data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.DataLocation = expandLakeFormationDataLocationResource(v[0].(map[string]interface{}))
+		resources++
+	}
+
+	if v, ok := tfMap["database"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.Database = expandLakeFormationDatabaseResource(v[0].(map[string]interface{}))
+		resources++
+	}
+
+	if v, ok := tfMap["lf_tag_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.LFTagPolicy = expandLakeFormationLFTagPolicyResource(v[0].(map[string]interface{}))
+		resources++
+	}
+
+	if v, ok := tfMap["table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if tfMap["name"].(string) == "" && !tfMap["wildcard"].(bool) {
+			return nil, fmt.Errorf("Lake Formation Permissions (batch) entry for principal (%s): one of table name or wildcard must be set", aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier))
+		}
+
+		apiObject.Resource.Table = expandLakeFormationTableResource(tfMap)
+		resources++
+	}
+
+	if resources != 1 {
+		return nil, fmt.Errorf("Lake Formation Permissions (batch) entry for principal (%s): exactly one of data_location, database, lf_tag_policy or table must be set", aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier))
+	}
+
+	return apiObject, nil
+}
+
+func expandLakeFormationLFTagPolicyResource(tfMap map[string]interface{}) *lakeformation.LFTagPolicyResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.LFTagPolicyResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["expression"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Expression = append(apiObject.Expression, &lakeformation.LFTag{
+				TagKey:    aws.String(tfMap["key"].(string)),
+				TagValues: expandStringSet(tfMap["values"].(*schema.Set)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["resource_type"].(string); ok && v != "" {
+		apiObject.ResourceType = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,446 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+)
+
+func TestLakeFormationBatchPermissionsRevokeEntries(t *testing.T) {
+	entry := func(principal string, permissions ...string) map[string]interface{} {
+		return map[string]interface{}{
+			"principal":                     principal,
+			"permissions":                   schema.NewSet(schema.HashString, flattenStringList(aws.StringSlice(permissions))),
+			"permissions_with_grant_option": schema.NewSet(schema.HashString, nil),
+			"database": []interface{}{map[string]interface{}{
+				"catalog_id": "",
+				"name":       "db",
+			}},
+		}
+	}
+
+	testCases := []struct {
+		Name      string
+		Removed   []interface{}
+		Remaining []interface{}
+		Expected  map[string][]string
+	}{
+		{
+			Name:      "entry removed",
+			Removed:   []interface{}{entry("role1", "ALTER", "DROP")},
+			Remaining: []interface{}{entry("role0", "ALTER", "DROP")},
+			Expected:  map[string][]string{"role1": {"ALTER", "DROP"}},
+		},
+		{
+			Name:      "permission removed from entry",
+			Removed:   []interface{}{entry("role1", "ALTER", "DROP")},
+			Remaining: []interface{}{entry("role0", "ALTER", "DROP"), entry("role1", "ALTER")},
+			Expected:  map[string][]string{"role1": {"DROP"}},
+		},
+		{
+			Name:      "permission added to entry",
+			Removed:   []interface{}{entry("role1", "ALTER")},
+			Remaining: []interface{}{entry("role0", "ALTER", "DROP"), entry("role1", "ALTER", "DROP")},
+			Expected:  map[string][]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := lakeFormationBatchPermissionsRevokeEntries(testCase.Removed, testCase.Remaining)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected %d entries to revoke, got %d: %s", len(testCase.Expected), len(got), got)
+			}
+
+			for _, apiObject := range got {
+				principal := aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier)
+				expected, ok := testCase.Expected[principal]
+
+				if !ok {
+					t.Fatalf("unexpected revoke for principal (%s): %s", principal, apiObject)
+				}
+
+				if !tflakeformation.StringSlicesEqualIgnoreOrder(apiObject.Permissions, aws.StringSlice(expected)) {
+					t.Errorf("principal (%s): expected permissions %v, got %v", principal, expected, aws.StringValueSlice(apiObject.Permissions))
+				}
+			}
+		})
+	}
+}
+
+func testAccAWSLakeFormationPermissionsBatch_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsBatchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsBatchConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsBatchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"database.#":      "1",
+						"database.0.name": rName,
+						"permissions.#":   "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissionsBatch_updateEntry(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsBatchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsBatchConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsBatchExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAWSLakeFormationPermissionsBatchConfig_updateEntry(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsBatchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"permissions.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"permissions.#": "1",
+					}),
+					// The untouched entry's grants are still in place: 2 + 1 permissions in total.
+					testAccCheckAWSLakeFormationPermissionsBatchPermissionCount(resourceName, 3),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissionsBatch_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsBatchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsBatchConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsBatchExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLakeFormationPermissionsBatch(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissionsBatch_permissionsRevoked(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsBatchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsBatchConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsBatchExists(resourceName),
+					testAccCheckAWSLakeFormationPermissionsBatchRevokePermission("aws_iam_role.test.0", "aws_glue_catalog_database.test", lakeformation.PermissionDrop),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckAWSLakeFormationPermissionsBatchRevokePermission revokes a single database permission
+// outside of Terraform so that the entry no longer matches what was granted.
+func testAccCheckAWSLakeFormationPermissionsBatchRevokePermission(roleResourceName, databaseResourceName, permission string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		role, ok := s.RootModule().Resources[roleResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", roleResourceName)
+		}
+
+		database, ok := s.RootModule().Resources[databaseResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", databaseResourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		_, err := conn.RevokePermissions(&lakeformation.RevokePermissionsInput{
+			Permissions: aws.StringSlice([]string{permission}),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(role.Primary.Attributes["arn"]),
+			},
+			Resource: &lakeformation.Resource{
+				Database: &lakeformation.DatabaseResource{
+					Name: aws.String(database.Primary.Attributes["name"]),
+				},
+			},
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSLakeFormationPermissionsBatchDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_permissions_batch" {
+			continue
+		}
+
+		permCount, err := permissionCountForLakeFormationPermissionsBatch(conn, rs)
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions (batch) (%s): %w", rs.Primary.ID, err)
+		}
+
+		if permCount != 0 {
+			return fmt.Errorf("acceptance test: Lake Formation permissions (batch) (%s) still exist: %d", rs.Primary.ID, permCount)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLakeFormationPermissionsBatchExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("acceptance test: resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		permCount, err := permissionCountForLakeFormationPermissionsBatch(conn, rs)
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions (batch) (%s): %w", rs.Primary.ID, err)
+		}
+
+		if permCount == 0 {
+			return fmt.Errorf("acceptance test: Lake Formation permissions (batch) (%s) do not exist or could not be found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLakeFormationPermissionsBatchPermissionCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("acceptance test: resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+
+		permCount, err := permissionCountForLakeFormationPermissionsBatch(conn, rs)
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions (batch) (%s): %w", rs.Primary.ID, err)
+		}
+
+		if permCount != expected {
+			return fmt.Errorf("acceptance test: Lake Formation permissions (batch) (%s): expected %d permissions, got %d", rs.Primary.ID, expected, permCount)
+		}
+
+		return nil
+	}
+}
+
+// permissionCountForLakeFormationPermissionsBatch counts the individual database permissions granted by the entries in state.
+func permissionCountForLakeFormationPermissionsBatch(conn *lakeformation.LakeFormation, rs *terraform.ResourceState) (int, error) {
+	count := 0
+
+	for k, principal := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "entry.") || !strings.HasSuffix(k, ".principal") {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(k, "principal")
+		databaseName := rs.Primary.Attributes[prefix+"database.0.name"]
+
+		if databaseName == "" {
+			continue
+		}
+
+		input := &lakeformation.ListPermissionsInput{
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(principal),
+			},
+			Resource: &lakeformation.Resource{
+				Database: &lakeformation.DatabaseResource{
+					Name: aws.String(databaseName),
+				},
+			},
+		}
+
+		permissions, err := finder.Permissions(conn, input)
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(flattenLakeFormationPermissions(tflakeformation.FilterLakeFormationDatabasePermissions(input.Principal.DataLakePrincipalIdentifier, permissions)))
+	}
+
+	return count, nil
+}
+
+func testAccAWSLakeFormationPermissionsBatchConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_batch" "test" {
+  dynamic "entry" {
+    for_each = aws_iam_role.test[*].arn
+
+    content {
+      permissions = ["ALTER", "DROP"]
+      principal   = entry.value
+
+      database {
+        name = aws_glue_catalog_database.test.name
+      }
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsBatchConfig_updateEntry(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_batch" "test" {
+  dynamic "entry" {
+    for_each = aws_iam_role.test[*].arn
+
+    content {
+      permissions = entry.key == 0 ? ["ALTER", "DROP"] : ["ALTER"]
+      principal   = entry.value
+
+      database {
+        name = aws_glue_catalog_database.test.name
+      }
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
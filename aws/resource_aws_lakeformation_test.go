@@ -20,6 +20,12 @@ func TestAccAWSLakeFormation_serial(t *testing.T) {
 			"dataLocation":       testAccAWSLakeFormationPermissions_dataLocation,
 			"disappears":         testAccAWSLakeFormationPermissions_disappears,
 		},
+		"PermissionsBatch": {
+			"basic":              testAccAWSLakeFormationPermissionsBatch_basic,
+			"disappears":         testAccAWSLakeFormationPermissionsBatch_disappears,
+			"permissionsRevoked": testAccAWSLakeFormationPermissionsBatch_permissionsRevoked,
+			"updateEntry":        testAccAWSLakeFormationPermissionsBatch_updateEntry,
+		},
 		"PermissionsDataSource": {
 			"basic":            testAccAWSLakeFormationPermissionsDataSource_basic,
 			"database":         testAccAWSLakeFormationPermissionsDataSource_database,
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLexV2ModelsBot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2ModelsBotCreate,
+		Read:   resourceAwsLexV2ModelsBotRead,
+		Update: resourceAwsLexV2ModelsBotUpdate,
+		Delete: resourceAwsLexV2ModelsBotDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_privacy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_directed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsLexV2ModelsBotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateBotInput{
+		BotName:                 aws.String(name),
+		DataPrivacy:             expandLexV2ModelsDataPrivacy(d.Get("data_privacy").([]interface{})),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.BotTags = tags.IgnoreAws().Lexmodelsv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot: %s", input)
+	output, err := conn.CreateBot(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 Bot (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BotId))
+
+	if _, err := waiter.BotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsBotRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.BotByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex V2 Bot (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("data_privacy", flattenLexV2ModelsDataPrivacy(output.DataPrivacy)); err != nil {
+		return fmt.Errorf("error setting data_privacy: %w", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+	d.Set("name", output.BotName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.BotStatus)
+
+	tags, err := keyvaluetags.Lexmodelsv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Lex V2 Bot (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2ModelsBotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &lexmodelsv2.UpdateBotInput{
+			BotId:                   aws.String(d.Id()),
+			BotName:                 aws.String(d.Get("name").(string)),
+			DataPrivacy:             expandLexV2ModelsDataPrivacy(d.Get("data_privacy").([]interface{})),
+			IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+			RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Lex V2 Bot: %s", input)
+		_, err := conn.UpdateBot(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lex V2 Bot (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.BotAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lex V2 Bot (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Lexmodelsv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Lex V2 Bot (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLexV2ModelsBotRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsBotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot: %s", d.Id())
+	_, err := conn.DeleteBot(&lexmodelsv2.DeleteBotInput{
+		BotId:                  aws.String(d.Id()),
+		SkipResourceInUseCheck: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 Bot (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.BotDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexV2ModelsDataPrivacy(tfList []interface{}) *lexmodelsv2.DataPrivacy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &lexmodelsv2.DataPrivacy{
+		ChildDirected: aws.Bool(tfMap["child_directed"].(bool)),
+	}
+}
+
+func flattenLexV2ModelsDataPrivacy(apiObject *lexmodelsv2.DataPrivacy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"child_directed": aws.BoolValue(apiObject.ChildDirected),
+	}
+
+	return []interface{}{tfMap}
+}
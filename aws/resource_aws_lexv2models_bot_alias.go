@@ -0,0 +1,358 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const lexV2ModelsCodeHookInterfaceVersion = "1.0"
+
+func resourceAwsLexV2ModelsBotAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2ModelsBotAliasCreate,
+		Read:   resourceAwsLexV2ModelsBotAliasRead,
+		Update: resourceAwsLexV2ModelsBotAliasUpdate,
+		Delete: resourceAwsLexV2ModelsBotAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_alias_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_alias_locale_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"lambda_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"locale_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"sentiment_analysis_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsLexV2ModelsBotAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	botID := d.Get("bot_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateBotAliasInput{
+		BotAliasName: aws.String(name),
+		BotId:        aws.String(botID),
+		SentimentAnalysisSettings: &lexmodelsv2.SentimentAnalysisSettings{
+			DetectSentiment: aws.Bool(d.Get("sentiment_analysis_enabled").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("bot_alias_locale_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.BotAliasLocaleSettings = expandLexV2ModelsBotAliasLocaleSettings(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("bot_version"); ok {
+		input.BotVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Lexmodelsv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot Alias: %s", input)
+	output, err := conn.CreateBotAlias(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 Bot Alias (%s): %w", name, err)
+	}
+
+	botAliasID := aws.StringValue(output.BotAliasId)
+	d.SetId(tflexmodelsv2.BotAliasCreateResourceID(botID, botAliasID))
+
+	if _, err := waiter.BotAliasAvailable(conn, botAliasID, botID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot Alias (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsBotAliasRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsBotAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	botID, botAliasID, err := tflexmodelsv2.BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.BotAliasByID(conn, botAliasID, botID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex V2 Bot Alias (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot-alias/%s/%s", botID, botAliasID),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("bot_alias_id", output.BotAliasId)
+	if err := d.Set("bot_alias_locale_settings", flattenLexV2ModelsBotAliasLocaleSettings(output.BotAliasLocaleSettings)); err != nil {
+		return fmt.Errorf("error setting bot_alias_locale_settings: %w", err)
+	}
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("name", output.BotAliasName)
+	if output.SentimentAnalysisSettings != nil {
+		d.Set("sentiment_analysis_enabled", output.SentimentAnalysisSettings.DetectSentiment)
+	} else {
+		d.Set("sentiment_analysis_enabled", false)
+	}
+	d.Set("status", output.BotAliasStatus)
+
+	tags, err := keyvaluetags.Lexmodelsv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Lex V2 Bot Alias (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2ModelsBotAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, botAliasID, err := tflexmodelsv2.BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &lexmodelsv2.UpdateBotAliasInput{
+			BotAliasId:   aws.String(botAliasID),
+			BotAliasName: aws.String(d.Get("name").(string)),
+			BotId:        aws.String(botID),
+			SentimentAnalysisSettings: &lexmodelsv2.SentimentAnalysisSettings{
+				DetectSentiment: aws.Bool(d.Get("sentiment_analysis_enabled").(bool)),
+			},
+		}
+
+		if v, ok := d.GetOk("bot_alias_locale_settings"); ok && v.(*schema.Set).Len() > 0 {
+			input.BotAliasLocaleSettings = expandLexV2ModelsBotAliasLocaleSettings(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("bot_version"); ok {
+			input.BotVersion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Lex V2 Bot Alias: %s", input)
+		_, err := conn.UpdateBotAlias(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lex V2 Bot Alias (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.BotAliasAvailable(conn, botAliasID, botID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lex V2 Bot Alias (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Lexmodelsv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Lex V2 Bot Alias (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLexV2ModelsBotAliasRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsBotAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, botAliasID, err := tflexmodelsv2.BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Alias: %s", d.Id())
+	_, err = conn.DeleteBotAlias(&lexmodelsv2.DeleteBotAliasInput{
+		BotAliasId:             aws.String(botAliasID),
+		BotId:                  aws.String(botID),
+		SkipResourceInUseCheck: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 Bot Alias (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.BotAliasDeleted(conn, botAliasID, botID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot Alias (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexV2ModelsBotAliasLocaleSettings(tfList []interface{}) map[string]*lexmodelsv2.BotAliasLocaleSettings {
+	apiObjects := map[string]*lexmodelsv2.BotAliasLocaleSettings{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.BotAliasLocaleSettings{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["lambda_arn"].(string); ok && v != "" {
+			apiObject.CodeHookSpecification = &lexmodelsv2.CodeHookSpecification{
+				LambdaCodeHook: &lexmodelsv2.LambdaCodeHook{
+					CodeHookInterfaceVersion: aws.String(lexV2ModelsCodeHookInterfaceVersion),
+					LambdaARN:                aws.String(v),
+				},
+			}
+		}
+
+		apiObjects[tfMap["locale_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenLexV2ModelsBotAliasLocaleSettings(apiObjects map[string]*lexmodelsv2.BotAliasLocaleSettings) []interface{} {
+	var tfList []interface{}
+
+	for localeID, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"enabled":   aws.BoolValue(apiObject.Enabled),
+			"locale_id": localeID,
+		}
+
+		if v := apiObject.CodeHookSpecification; v != nil && v.LambdaCodeHook != nil {
+			tfMap["lambda_arn"] = aws.StringValue(v.LambdaCodeHook.LambdaARN)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSLexV2ModelsBotAlias_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeBotAliasOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot_alias.test"
+	botResourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotAliasConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotAliasExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`bot-alias/.+/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "bot_alias_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_alias_locale_settings.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_version", "aws_lexv2models_bot_version.test", "bot_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sentiment_analysis_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelsv2.BotAliasStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexV2ModelsBotAlias_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeBotAliasOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotAliasConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotAliasExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLexV2ModelsBotAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexV2ModelsBotAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_bot_alias" {
+			continue
+		}
+
+		botID, botAliasID, err := tflexmodelsv2.BotAliasParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.BotAliasByID(conn, botAliasID, botID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Bot Alias %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexV2ModelsBotAliasExists(n string, v *lexmodelsv2.DescribeBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Alias ID is set")
+		}
+
+		botID, botAliasID, err := tflexmodelsv2.BotAliasParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+		output, err := finder.BotAliasByID(conn, botAliasID, botID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexV2ModelsBotAliasConfig(rName string) string {
+	return composeConfig(testAccAWSLexV2ModelsBotVersionConfig(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+  name        = %[1]q
+
+  bot_alias_locale_settings {
+    locale_id = aws_lexv2models_bot_locale.test.locale_id
+    enabled   = true
+  }
+}
+`, rName))
+}
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLexV2ModelsBotLocale() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2ModelsBotLocaleCreate,
+		Read:   resourceAwsLexV2ModelsBotLocaleRead,
+		Update: resourceAwsLexV2ModelsBotLocaleUpdate,
+		Delete: resourceAwsLexV2ModelsBotLocaleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nlu_intent_confidence_threshold": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"voice_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2ModelsBotLocaleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	localeID := d.Get("locale_id").(string)
+	id := tflexmodelsv2.BotLocaleCreateResourceID(botID, localeID)
+	input := &lexmodelsv2.CreateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(tflexmodelsv2.BotVersionDraft),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok {
+		input.VoiceSettings = expandLexV2ModelsVoiceSettings(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot Locale: %s", input)
+	_, err := conn.CreateBotLocale(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 Bot Locale (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.BotLocaleCreated(conn, botID, tflexmodelsv2.BotVersionDraft, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot Locale (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsBotLocaleRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsBotLocaleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, err := tflexmodelsv2.BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.BotLocaleByID(conn, botID, tflexmodelsv2.BotVersionDraft, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Locale (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex V2 Bot Locale (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("description", output.Description)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("locale_name", output.LocaleName)
+	d.Set("nlu_intent_confidence_threshold", output.NluIntentConfidenceThreshold)
+	d.Set("status", output.BotLocaleStatus)
+	if err := d.Set("voice_settings", flattenLexV2ModelsVoiceSettings(output.VoiceSettings)); err != nil {
+		return fmt.Errorf("error setting voice_settings: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2ModelsBotLocaleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, err := tflexmodelsv2.BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lexmodelsv2.UpdateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(tflexmodelsv2.BotVersionDraft),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok {
+		input.VoiceSettings = expandLexV2ModelsVoiceSettings(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Bot Locale: %s", input)
+	_, err = conn.UpdateBotLocale(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex V2 Bot Locale (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsBotLocaleRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsBotLocaleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, err := tflexmodelsv2.BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Locale: %s", d.Id())
+	_, err = conn.DeleteBotLocale(&lexmodelsv2.DeleteBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		LocaleId:   aws.String(localeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 Bot Locale (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.BotLocaleDeleted(conn, botID, tflexmodelsv2.BotVersionDraft, localeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot Locale (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexV2ModelsVoiceSettings(tfList []interface{}) *lexmodelsv2.VoiceSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &lexmodelsv2.VoiceSettings{
+		VoiceId: aws.String(tfMap["voice_id"].(string)),
+	}
+}
+
+func flattenLexV2ModelsVoiceSettings(apiObject *lexmodelsv2.VoiceSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"voice_id": aws.StringValue(apiObject.VoiceId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSLexV2ModelsBotLocale_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeBotLocaleOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot_locale.test"
+	botResourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotLocaleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotLocaleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotLocaleExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "locale_name", "English (US)"),
+					resource.TestCheckResourceAttr(resourceName, "nlu_intent_confidence_threshold", "0.7"),
+					resource.TestCheckResourceAttr(resourceName, "voice_settings.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexV2ModelsBotLocale_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeBotLocaleOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotLocaleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotLocaleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotLocaleExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLexV2ModelsBotLocale(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexV2ModelsBotLocaleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_bot_locale" {
+			continue
+		}
+
+		botID, localeID, err := tflexmodelsv2.BotLocaleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.BotLocaleByID(conn, botID, tflexmodelsv2.BotVersionDraft, localeID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Bot Locale %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexV2ModelsBotLocaleExists(n string, v *lexmodelsv2.DescribeBotLocaleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Locale ID is set")
+		}
+
+		botID, localeID, err := tflexmodelsv2.BotLocaleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+		output, err := finder.BotLocaleByID(conn, botID, tflexmodelsv2.BotVersionDraft, localeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexV2ModelsBotLocaleConfig(rName string) string {
+	return composeConfig(testAccAWSLexV2ModelsBotConfig(rName), `
+resource "aws_lexv2models_bot_locale" "test" {
+  bot_id                          = aws_lexv2models_bot.test.id
+  locale_id                       = "en_US"
+  nlu_intent_confidence_threshold = 0.7
+}
+`)
+}
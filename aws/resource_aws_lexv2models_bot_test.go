@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSLexV2ModelsBot_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeBotOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`bot/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_privacy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_privacy.0.child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelsv2.BotStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexV2ModelsBot_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeBotOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLexV2ModelsBot(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexV2ModelsBot_tags(t *testing.T) {
+	var v lexmodelsv2.DescribeBotOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLexV2ModelsBotConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSLexV2ModelsBotConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexV2ModelsBotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_bot" {
+			continue
+		}
+
+		_, err := finder.BotByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Bot %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexV2ModelsBotExists(n string, v *lexmodelsv2.DescribeBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+		output, err := finder.BotByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexV2ModelsBotConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lexv2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonPollyReadOnlyAccess"
+}
+`, rName)
+}
+
+func testAccAWSLexV2ModelsBotConfig(rName string) string {
+	return composeConfig(testAccAWSLexV2ModelsBotConfigBase(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 300
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = false
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccAWSLexV2ModelsBotConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSLexV2ModelsBotConfigBase(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 300
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSLexV2ModelsBotConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSLexV2ModelsBotConfigBase(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 300
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLexV2ModelsBotVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2ModelsBotVersionCreate,
+		Read:   resourceAwsLexV2ModelsBotVersionRead,
+		Delete: resourceAwsLexV2ModelsBotVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_specification": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2ModelsBotVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	localeSpecification := map[string]*lexmodelsv2.BotVersionLocaleDetails{}
+
+	for localeID, v := range d.Get("locale_specification").(map[string]interface{}) {
+		sourceBotVersion := v.(string)
+
+		// Locales must be built before the bot can be versioned.
+		if sourceBotVersion == tflexmodelsv2.BotVersionDraft {
+			log.Printf("[DEBUG] Building Lex V2 Bot Locale: %s", tflexmodelsv2.BotLocaleCreateResourceID(botID, localeID))
+			_, err := conn.BuildBotLocale(&lexmodelsv2.BuildBotLocaleInput{
+				BotId:      aws.String(botID),
+				BotVersion: aws.String(sourceBotVersion),
+				LocaleId:   aws.String(localeID),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error building Lex V2 Bot Locale (%s): %w", tflexmodelsv2.BotLocaleCreateResourceID(botID, localeID), err)
+			}
+
+			if _, err := waiter.BotLocaleBuilt(conn, botID, sourceBotVersion, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return fmt.Errorf("error waiting for Lex V2 Bot Locale (%s) build: %w", tflexmodelsv2.BotLocaleCreateResourceID(botID, localeID), err)
+			}
+		}
+
+		localeSpecification[localeID] = &lexmodelsv2.BotVersionLocaleDetails{
+			SourceBotVersion: aws.String(sourceBotVersion),
+		}
+	}
+
+	input := &lexmodelsv2.CreateBotVersionInput{
+		BotId:                         aws.String(botID),
+		BotVersionLocaleSpecification: localeSpecification,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot Version: %s", input)
+	output, err := conn.CreateBotVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 Bot (%s) Version: %w", botID, err)
+	}
+
+	d.SetId(tflexmodelsv2.BotVersionCreateResourceID(botID, aws.StringValue(output.BotVersion)))
+
+	if _, err := waiter.BotVersionAvailable(conn, botID, aws.StringValue(output.BotVersion), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot Version (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsBotVersionRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsBotVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, botVersion, err := tflexmodelsv2.BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.BotVersionByID(conn, botID, botVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex V2 Bot Version (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("status", output.BotStatus)
+
+	return nil
+}
+
+func resourceAwsLexV2ModelsBotVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, botVersion, err := tflexmodelsv2.BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Version: %s", d.Id())
+	_, err = conn.DeleteBotVersion(&lexmodelsv2.DeleteBotVersionInput{
+		BotId:                  aws.String(botID),
+		BotVersion:             aws.String(botVersion),
+		SkipResourceInUseCheck: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 Bot Version (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.BotVersionDeleted(conn, botID, botVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lex V2 Bot Version (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSLexV2ModelsBotVersion_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeBotVersionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot_version.test"
+	botResourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale_specification.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale_specification.en_US", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelsv2.BotStatusAvailable),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"locale_specification"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLexV2ModelsBotVersion_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeBotVersionOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_bot_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsBotVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsBotVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsBotVersionExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLexV2ModelsBotVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexV2ModelsBotVersionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_bot_version" {
+			continue
+		}
+
+		botID, botVersion, err := tflexmodelsv2.BotVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.BotVersionByID(conn, botID, botVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Bot Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexV2ModelsBotVersionExists(n string, v *lexmodelsv2.DescribeBotVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Version ID is set")
+		}
+
+		botID, botVersion, err := tflexmodelsv2.BotVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+		output, err := finder.BotVersionByID(conn, botID, botVersion)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexV2ModelsBotVersionConfig(rName string) string {
+	return composeConfig(testAccAWSLexV2ModelsIntentConfig(rName), `
+resource "aws_lexv2models_bot_version" "test" {
+  bot_id = aws_lexv2models_bot.test.id
+
+  locale_specification = {
+    (aws_lexv2models_bot_locale.test.locale_id) = "DRAFT"
+  }
+
+  depends_on = [aws_lexv2models_intent.test]
+}
+`)
+}
@@ -0,0 +1,372 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLexV2ModelsIntent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2ModelsIntentCreate,
+		Read:   resourceAwsLexV2ModelsIntentRead,
+		Update: resourceAwsLexV2ModelsIntentUpdate,
+		Delete: resourceAwsLexV2ModelsIntentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"fulfillment_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"sample_utterances": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot_priority": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"slot_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2ModelsIntentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		IntentName: aws.String(name),
+		LocaleId:   aws.String(localeID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dialog_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DialogCodeHook = &lexmodelsv2.DialogCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("fulfillment_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FulfillmentCodeHook = &lexmodelsv2.FulfillmentCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterances"); ok && len(v.([]interface{})) > 0 {
+		input.SampleUtterances = expandLexV2ModelsSampleUtterances(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Intent: %s", input)
+	output, err := conn.CreateIntent(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 Intent (%s): %w", name, err)
+	}
+
+	d.SetId(tflexmodelsv2.IntentCreateResourceID(botID, localeID, aws.StringValue(output.IntentId)))
+
+	// Slot priorities reference slots, which can only be created once the intent exists.
+	if _, ok := d.GetOk("slot_priority"); ok {
+		return resourceAwsLexV2ModelsIntentUpdate(d, meta)
+	}
+
+	return resourceAwsLexV2ModelsIntentRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, intentID, err := tflexmodelsv2.IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.IntentByID(conn, intentID, botID, tflexmodelsv2.BotVersionDraft, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex V2 Intent (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("description", output.Description)
+	if output.DialogCodeHook != nil {
+		if err := d.Set("dialog_code_hook", []interface{}{map[string]interface{}{"enabled": aws.BoolValue(output.DialogCodeHook.Enabled)}}); err != nil {
+			return fmt.Errorf("error setting dialog_code_hook: %w", err)
+		}
+	} else {
+		d.Set("dialog_code_hook", nil)
+	}
+	if output.FulfillmentCodeHook != nil {
+		if err := d.Set("fulfillment_code_hook", []interface{}{map[string]interface{}{"enabled": aws.BoolValue(output.FulfillmentCodeHook.Enabled)}}); err != nil {
+			return fmt.Errorf("error setting fulfillment_code_hook: %w", err)
+		}
+	} else {
+		d.Set("fulfillment_code_hook", nil)
+	}
+	d.Set("intent_id", output.IntentId)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.IntentName)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+	if err := d.Set("sample_utterances", flattenLexV2ModelsSampleUtterances(output.SampleUtterances)); err != nil {
+		return fmt.Errorf("error setting sample_utterances: %w", err)
+	}
+	if err := d.Set("slot_priority", flattenLexV2ModelsSlotPriorities(output.SlotPriorities)); err != nil {
+		return fmt.Errorf("error setting slot_priority: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2ModelsIntentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, intentID, err := tflexmodelsv2.IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// UpdateIntent replaces the whole intent definition.
+	input := &lexmodelsv2.UpdateIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		IntentId:   aws.String(intentID),
+		IntentName: aws.String(d.Get("name").(string)),
+		LocaleId:   aws.String(localeID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dialog_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DialogCodeHook = &lexmodelsv2.DialogCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("fulfillment_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FulfillmentCodeHook = &lexmodelsv2.FulfillmentCodeHookSettings{
+			Enabled: aws.Bool(v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterances"); ok && len(v.([]interface{})) > 0 {
+		input.SampleUtterances = expandLexV2ModelsSampleUtterances(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("slot_priority"); ok && len(v.([]interface{})) > 0 {
+		input.SlotPriorities = expandLexV2ModelsSlotPriorities(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Intent: %s", input)
+	_, err = conn.UpdateIntent(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex V2 Intent (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsIntentRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsIntentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, intentID, err := tflexmodelsv2.IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Intent: %s", d.Id())
+	_, err = conn.DeleteIntent(&lexmodelsv2.DeleteIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 Intent (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexV2ModelsSampleUtterances(tfList []interface{}) []*lexmodelsv2.SampleUtterance {
+	var apiObjects []*lexmodelsv2.SampleUtterance
+
+	for _, tfListRaw := range tfList {
+		v, ok := tfListRaw.(string)
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.SampleUtterance{
+			Utterance: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLexV2ModelsSampleUtterances(apiObjects []*lexmodelsv2.SampleUtterance) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.Utterance))
+	}
+
+	return tfList
+}
+
+func expandLexV2ModelsSlotPriorities(tfList []interface{}) []*lexmodelsv2.SlotPriority {
+	var apiObjects []*lexmodelsv2.SlotPriority
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.SlotPriority{
+			Priority: aws.Int64(int64(tfMap["priority"].(int))),
+			SlotId:   aws.String(tfMap["slot_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLexV2ModelsSlotPriorities(apiObjects []*lexmodelsv2.SlotPriority) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"priority": aws.Int64Value(apiObject.Priority),
+			"slot_id":  aws.StringValue(apiObject.SlotId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSLexV2ModelsIntent_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeIntentOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_intent.test"
+	botResourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsIntentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsIntentExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "intent_id"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.0", "I want to order flowers"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexV2ModelsIntent_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeIntentOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsIntentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsIntentExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLexV2ModelsIntent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexV2ModelsIntentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_intent" {
+			continue
+		}
+
+		botID, localeID, intentID, err := tflexmodelsv2.IntentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.IntentByID(conn, intentID, botID, tflexmodelsv2.BotVersionDraft, localeID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Intent %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexV2ModelsIntentExists(n string, v *lexmodelsv2.DescribeIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Intent ID is set")
+		}
+
+		botID, localeID, intentID, err := tflexmodelsv2.IntentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+		output, err := finder.IntentByID(conn, intentID, botID, tflexmodelsv2.BotVersionDraft, localeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexV2ModelsIntentConfig(rName string) string {
+	return composeConfig(testAccAWSLexV2ModelsBotLocaleConfig(rName), fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = %[1]q
+
+  sample_utterances = [
+    "I want to order flowers",
+    "Can I buy some flowers",
+  ]
+}
+`, rName))
+}
@@ -0,0 +1,309 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLexV2ModelsSlot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2ModelsSlotCreate,
+		Read:   resourceAwsLexV2ModelsSlotRead,
+		Update: resourceAwsLexV2ModelsSlotUpdate,
+		Delete: resourceAwsLexV2ModelsSlotDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"obfuscation_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexmodelsv2.ObfuscationSettingTypeNone,
+				ValidateFunc: validation.StringInSlice(lexmodelsv2.ObfuscationSettingType_Values(), false),
+			},
+			"prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_interrupt": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 5),
+						},
+						"messages": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+						},
+					},
+				},
+			},
+			"slot_constraint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotConstraint_Values(), false),
+			},
+			"slot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2ModelsSlotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	localeID := d.Get("locale_id").(string)
+	intentID := d.Get("intent_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		ObfuscationSetting: &lexmodelsv2.ObfuscationSetting{
+			ObfuscationSettingType: aws.String(d.Get("obfuscation_type").(string)),
+		},
+		SlotName:                aws.String(name),
+		SlotTypeId:              aws.String(d.Get("slot_type_id").(string)),
+		ValueElicitationSetting: expandLexV2ModelsSlotValueElicitationSetting(d),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Slot: %s", input)
+	output, err := conn.CreateSlot(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 Slot (%s): %w", name, err)
+	}
+
+	d.SetId(tflexmodelsv2.SlotCreateResourceID(botID, localeID, intentID, aws.StringValue(output.SlotId)))
+
+	return resourceAwsLexV2ModelsSlotRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsSlotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, intentID, slotID, err := tflexmodelsv2.SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.SlotByID(conn, slotID, botID, tflexmodelsv2.BotVersionDraft, localeID, intentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Slot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex V2 Slot (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("description", output.Description)
+	d.Set("intent_id", output.IntentId)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.SlotName)
+	if output.ObfuscationSetting != nil {
+		d.Set("obfuscation_type", output.ObfuscationSetting.ObfuscationSettingType)
+	} else {
+		d.Set("obfuscation_type", lexmodelsv2.ObfuscationSettingTypeNone)
+	}
+	d.Set("slot_id", output.SlotId)
+	d.Set("slot_type_id", output.SlotTypeId)
+
+	if v := output.ValueElicitationSetting; v != nil {
+		d.Set("slot_constraint", v.SlotConstraint)
+		if err := d.Set("prompt", flattenLexV2ModelsPromptSpecification(v.PromptSpecification)); err != nil {
+			return fmt.Errorf("error setting prompt: %w", err)
+		}
+	} else {
+		d.Set("slot_constraint", nil)
+		d.Set("prompt", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2ModelsSlotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, intentID, slotID, err := tflexmodelsv2.SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lexmodelsv2.UpdateSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		ObfuscationSetting: &lexmodelsv2.ObfuscationSetting{
+			ObfuscationSettingType: aws.String(d.Get("obfuscation_type").(string)),
+		},
+		SlotId:                  aws.String(slotID),
+		SlotName:                aws.String(d.Get("name").(string)),
+		SlotTypeId:              aws.String(d.Get("slot_type_id").(string)),
+		ValueElicitationSetting: expandLexV2ModelsSlotValueElicitationSetting(d),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Slot: %s", input)
+	_, err = conn.UpdateSlot(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex V2 Slot (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsSlotRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsSlotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, intentID, slotID, err := tflexmodelsv2.SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Slot: %s", d.Id())
+	_, err = conn.DeleteSlot(&lexmodelsv2.DeleteSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		SlotId:     aws.String(slotID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 Slot (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexV2ModelsSlotValueElicitationSetting(d *schema.ResourceData) *lexmodelsv2.SlotValueElicitationSetting {
+	apiObject := &lexmodelsv2.SlotValueElicitationSetting{
+		SlotConstraint: aws.String(d.Get("slot_constraint").(string)),
+	}
+
+	if v, ok := d.GetOk("prompt"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		promptSpecification := &lexmodelsv2.PromptSpecification{
+			AllowInterrupt: aws.Bool(tfMap["allow_interrupt"].(bool)),
+			MaxRetries:     aws.Int64(int64(tfMap["max_retries"].(int))),
+		}
+
+		for _, v := range tfMap["messages"].([]interface{}) {
+			promptSpecification.MessageGroups = append(promptSpecification.MessageGroups, &lexmodelsv2.MessageGroup{
+				Message: &lexmodelsv2.Message{
+					PlainTextMessage: &lexmodelsv2.PlainTextMessage{
+						Value: aws.String(v.(string)),
+					},
+				},
+			})
+		}
+
+		apiObject.PromptSpecification = promptSpecification
+	}
+
+	return apiObject
+}
+
+func flattenLexV2ModelsPromptSpecification(apiObject *lexmodelsv2.PromptSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var messages []interface{}
+	for _, v := range apiObject.MessageGroups {
+		if v == nil || v.Message == nil || v.Message.PlainTextMessage == nil {
+			continue
+		}
+
+		messages = append(messages, aws.StringValue(v.Message.PlainTextMessage.Value))
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_interrupt": aws.BoolValue(apiObject.AllowInterrupt),
+		"max_retries":     aws.Int64Value(apiObject.MaxRetries),
+		"messages":        messages,
+	}
+
+	return []interface{}{tfMap}
+}
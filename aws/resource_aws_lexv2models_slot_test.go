@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSLexV2ModelsSlot_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeSlotOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_slot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsSlotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsSlotExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "intent_id", "aws_lexv2models_intent.test", "intent_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "obfuscation_type", lexmodelsv2.ObfuscationSettingTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "prompt.0.max_retries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "prompt.0.messages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slot_constraint", lexmodelsv2.SlotConstraintRequired),
+					resource.TestCheckResourceAttrSet(resourceName, "slot_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "slot_type_id", "aws_lexv2models_slot_type.test", "slot_type_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexV2ModelsSlot_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeSlotOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lexv2models_slot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lexmodelsv2.EndpointsID, t) },
+		ErrorCheck:   testAccErrorCheck(t, lexmodelsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexV2ModelsSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexV2ModelsSlotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexV2ModelsSlotExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLexV2ModelsSlot(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexV2ModelsSlotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_slot" {
+			continue
+		}
+
+		botID, localeID, intentID, slotID, err := tflexmodelsv2.SlotParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.SlotByID(conn, slotID, botID, tflexmodelsv2.BotVersionDraft, localeID, intentID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Slot %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexV2ModelsSlotExists(n string, v *lexmodelsv2.DescribeSlotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Slot ID is set")
+		}
+
+		botID, localeID, intentID, slotID, err := tflexmodelsv2.SlotParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelsv2conn
+
+		output, err := finder.SlotByID(conn, slotID, botID, tflexmodelsv2.BotVersionDraft, localeID, intentID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexV2ModelsSlotConfig(rName string) string {
+	return composeConfig(testAccAWSLexV2ModelsIntentConfig(rName), fmt.Sprintf(`
+resource "aws_lexv2models_slot_type" "test" {
+  bot_id                   = aws_lexv2models_bot.test.id
+  locale_id                = aws_lexv2models_bot_locale.test.locale_id
+  name                     = %[1]q
+  value_selection_strategy = "ORIGINAL_VALUE"
+
+  slot_type_values {
+    sample_value = "roses"
+  }
+}
+
+resource "aws_lexv2models_slot" "test" {
+  bot_id          = aws_lexv2models_bot.test.id
+  locale_id       = aws_lexv2models_bot_locale.test.locale_id
+  intent_id       = aws_lexv2models_intent.test.intent_id
+  name            = %[1]q
+  slot_type_id    = aws_lexv2models_slot_type.test.slot_type_id
+  slot_constraint = "Required"
+
+  prompt {
+    max_retries = 2
+    messages    = ["What type of flowers would you like to order?"]
+  }
+}
+`, rName))
+}
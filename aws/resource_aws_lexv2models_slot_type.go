@@ -0,0 +1,297 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tflexmodelsv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lexmodelsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLexV2ModelsSlotType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2ModelsSlotTypeCreate,
+		Read:   resourceAwsLexV2ModelsSlotTypeRead,
+		Update: resourceAwsLexV2ModelsSlotTypeUpdate,
+		Delete: resourceAwsLexV2ModelsSlotTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"parent_slot_type_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sample_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+						"synonyms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 140),
+							},
+						},
+					},
+				},
+			},
+			"value_selection_strategy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotValueResolutionStrategy_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2ModelsSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotTypeInput{
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(tflexmodelsv2.BotVersionDraft),
+		LocaleId:     aws.String(localeID),
+		SlotTypeName: aws.String(name),
+		ValueSelectionSetting: &lexmodelsv2.SlotValueSelectionSetting{
+			ResolutionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_values"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandLexV2ModelsSlotTypeValues(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Slot Type: %s", input)
+	output, err := conn.CreateSlotType(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 Slot Type (%s): %w", name, err)
+	}
+
+	d.SetId(tflexmodelsv2.SlotTypeCreateResourceID(botID, localeID, aws.StringValue(output.SlotTypeId)))
+
+	return resourceAwsLexV2ModelsSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, slotTypeID, err := tflexmodelsv2.SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.SlotTypeByID(conn, slotTypeID, botID, tflexmodelsv2.BotVersionDraft, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Slot Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex V2 Slot Type (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("description", output.Description)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.SlotTypeName)
+	d.Set("parent_slot_type_signature", output.ParentSlotTypeSignature)
+	d.Set("slot_type_id", output.SlotTypeId)
+	if err := d.Set("slot_type_values", flattenLexV2ModelsSlotTypeValues(output.SlotTypeValues)); err != nil {
+		return fmt.Errorf("error setting slot_type_values: %w", err)
+	}
+	if output.ValueSelectionSetting != nil {
+		d.Set("value_selection_strategy", output.ValueSelectionSetting.ResolutionStrategy)
+	} else {
+		d.Set("value_selection_strategy", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2ModelsSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, slotTypeID, err := tflexmodelsv2.SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lexmodelsv2.UpdateSlotTypeInput{
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(tflexmodelsv2.BotVersionDraft),
+		LocaleId:     aws.String(localeID),
+		SlotTypeId:   aws.String(slotTypeID),
+		SlotTypeName: aws.String(d.Get("name").(string)),
+		ValueSelectionSetting: &lexmodelsv2.SlotValueSelectionSetting{
+			ResolutionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_values"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandLexV2ModelsSlotTypeValues(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Slot Type: %s", input)
+	_, err = conn.UpdateSlotType(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex V2 Slot Type (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsLexV2ModelsSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexV2ModelsSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, localeID, slotTypeID, err := tflexmodelsv2.SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Slot Type: %s", d.Id())
+	_, err = conn.DeleteSlotType(&lexmodelsv2.DeleteSlotTypeInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(tflexmodelsv2.BotVersionDraft),
+		LocaleId:   aws.String(localeID),
+		SlotTypeId: aws.String(slotTypeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 Slot Type (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLexV2ModelsSlotTypeValues(tfList []interface{}) []*lexmodelsv2.SlotTypeValue {
+	var apiObjects []*lexmodelsv2.SlotTypeValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.SlotTypeValue{
+			SampleValue: &lexmodelsv2.SampleValue{
+				Value: aws.String(tfMap["sample_value"].(string)),
+			},
+		}
+
+		for _, v := range tfMap["synonyms"].([]interface{}) {
+			apiObject.Synonyms = append(apiObject.Synonyms, &lexmodelsv2.SampleValue{
+				Value: aws.String(v.(string)),
+			})
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLexV2ModelsSlotTypeValues(apiObjects []*lexmodelsv2.SlotTypeValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.SampleValue; v != nil {
+			tfMap["sample_value"] = aws.StringValue(v.Value)
+		}
+
+		var synonyms []interface{}
+		for _, v := range apiObject.Synonyms {
+			if v == nil {
+				continue
+			}
+
+			synonyms = append(synonyms, aws.StringValue(v.Value))
+		}
+		tfMap["synonyms"] = synonyms
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -254,6 +254,39 @@ func resourceAwsMqBroker() *schema.Resource {
 					},
 				},
 			},
+			"pending_authentication_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pending_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"pending_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pending_host_instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pending_security_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"publicly_accessible": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -435,17 +468,44 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("empty response while reading MQ broker (%s)", d.Id())
 	}
 
+	// Changes made without apply_immediately are held as pending values until
+	// the next maintenance window. Compare the configuration against those so
+	// that the same change is not planned, and sent, again on every apply.
+	authenticationStrategy := output.AuthenticationStrategy
+	if aws.StringValue(output.PendingAuthenticationStrategy) != "" {
+		authenticationStrategy = output.PendingAuthenticationStrategy
+	}
+
+	engineVersion := output.EngineVersion
+	if aws.StringValue(output.PendingEngineVersion) != "" {
+		engineVersion = output.PendingEngineVersion
+	}
+
+	hostInstanceType := output.HostInstanceType
+	if aws.StringValue(output.PendingHostInstanceType) != "" {
+		hostInstanceType = output.PendingHostInstanceType
+	}
+
+	securityGroups := output.SecurityGroups
+	if len(output.PendingSecurityGroups) > 0 {
+		securityGroups = output.PendingSecurityGroups
+	}
+
 	d.Set("arn", output.BrokerArn)
-	d.Set("authentication_strategy", output.AuthenticationStrategy)
+	d.Set("authentication_strategy", authenticationStrategy)
 	d.Set("auto_minor_version_upgrade", output.AutoMinorVersionUpgrade)
 	d.Set("broker_name", output.BrokerName)
 	d.Set("deployment_mode", output.DeploymentMode)
 	d.Set("engine_type", output.EngineType)
-	d.Set("engine_version", output.EngineVersion)
-	d.Set("host_instance_type", output.HostInstanceType)
+	d.Set("engine_version", engineVersion)
+	d.Set("host_instance_type", hostInstanceType)
 	d.Set("instances", flattenMqBrokerInstances(output.BrokerInstances))
+	d.Set("pending_authentication_strategy", output.PendingAuthenticationStrategy)
+	d.Set("pending_engine_version", output.PendingEngineVersion)
+	d.Set("pending_host_instance_type", output.PendingHostInstanceType)
+	d.Set("pending_security_groups", aws.StringValueSlice(output.PendingSecurityGroups))
 	d.Set("publicly_accessible", output.PubliclyAccessible)
-	d.Set("security_groups", aws.StringValueSlice(output.SecurityGroups))
+	d.Set("security_groups", aws.StringValueSlice(securityGroups))
 	d.Set("storage_type", output.StorageType)
 	d.Set("subnet_ids", aws.StringValueSlice(output.SubnetIds))
 
@@ -457,6 +517,10 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting encryption_options: %w", err)
 	}
 
+	if err := d.Set("pending_configuration", flattenMqPendingConfiguration(output.Configurations)); err != nil {
+		return fmt.Errorf("error setting pending_configuration: %w", err)
+	}
+
 	var password string
 	if v, ok := d.GetOk("ldap_server_metadata.0.service_account_password"); ok {
 		password = v.(string)
@@ -901,13 +965,35 @@ func expandMqConfigurationId(cfg []interface{}) *mq.ConfigurationId {
 }
 
 func flattenMqConfiguration(config *mq.Configurations) []interface{} {
-	if config == nil || config.Current == nil {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	current := config.Current
+	if config.Pending != nil {
+		current = config.Pending
+	}
+
+	if current == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"id":       aws.StringValue(current.Id),
+		"revision": aws.Int64Value(current.Revision),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenMqPendingConfiguration(config *mq.Configurations) []interface{} {
+	if config == nil || config.Pending == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"id":       aws.StringValue(config.Current.Id),
-		"revision": aws.Int64Value(config.Current.Revision),
+		"id":       aws.StringValue(config.Pending.Id),
+		"revision": aws.Int64Value(config.Pending.Revision),
 	}
 
 	return []interface{}{m}
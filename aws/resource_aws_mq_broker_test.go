@@ -955,6 +955,45 @@ func TestAccAWSMqBroker_updateEngineVersion(t *testing.T) {
 	})
 }
 
+func TestAccAWSMqBroker_updateEngineVersion_maintenanceWindow(t *testing.T) {
+	var broker mq.DescribeBrokerResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(mq.EndpointsID, t)
+			testAccPreCheckAWSMq(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, mq.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMqBrokerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMqBrokerEngineVersionMaintenanceWindowConfig(rName, "5.15.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMqBrokerExists(resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "5.15.0"),
+					resource.TestCheckResourceAttr(resourceName, "pending_engine_version", ""),
+				),
+			},
+			{
+				Config: testAccMqBrokerEngineVersionMaintenanceWindowConfig(rName, "5.15.9"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMqBrokerExists(resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "5.15.9"),
+					resource.TestCheckResourceAttr(resourceName, "pending_engine_version", "5.15.9"),
+				),
+			},
+			{
+				Config:   testAccMqBrokerEngineVersionMaintenanceWindowConfig(rName, "5.15.9"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSMqBroker_disappears(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -1347,6 +1386,32 @@ resource "aws_mq_broker" "test" {
 `, rName)
 }
 
+func testAccMqBrokerEngineVersionMaintenanceWindowConfig(rName, engineVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mq_broker" "test" {
+  broker_name        = %[1]q
+  apply_immediately  = false
+  engine_type        = "ActiveMQ"
+  engine_version     = %[2]q
+  host_instance_type = "mq.t2.micro"
+  security_groups    = [aws_security_group.test.id]
+
+  logs {
+    general = true
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, engineVersion)
+}
+
 func testAccMqBrokerConfig_allFieldsDefaultVpc(rName, cfgName, cfgBody string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "mq1" {
@@ -26,6 +26,9 @@ func TestAccAWSOrganizations_serial(t *testing.T) {
 			"Name":       testAccAwsOrganizationsOrganizationalUnit_Name,
 			"Tags":       testAccAwsOrganizationsOrganizationalUnit_Tags,
 		},
+		"EffectivePolicy": {
+			"DataSource": testAccDataSourceAwsOrganizationsEffectivePolicy_basic,
+		},
 		"OrganizationalUnits": {
 			"DataSource": testAccDataSourceAwsOrganizationsOrganizationalUnits_basic,
 		},
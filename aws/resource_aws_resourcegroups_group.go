@@ -3,12 +3,15 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/resourcegroups/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/resourcegroups/waiter"
 )
 
 func resourceAwsResourceGroupsGroup() *schema.Resource {
@@ -22,6 +25,11 @@ func resourceAwsResourceGroupsGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -34,11 +42,43 @@ func resourceAwsResourceGroupsGroup() *schema.Resource {
 				Optional: true,
 			},
 
+			"configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameters": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"resource_query": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"configuration", "resource_query"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"query": {
@@ -85,10 +125,17 @@ func resourceAwsResourceGroupsGroupCreate(d *schema.ResourceData, meta interface
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	input := resourcegroups.CreateGroupInput{
-		Description:   aws.String(d.Get("description").(string)),
-		Name:          aws.String(d.Get("name").(string)),
-		ResourceQuery: extractResourceGroupResourceQuery(d.Get("resource_query").([]interface{})),
-		Tags:          tags.IgnoreAws().ResourcegroupsTags(),
+		Description: aws.String(d.Get("description").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+		Tags:        tags.IgnoreAws().ResourcegroupsTags(),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && v.(*schema.Set).Len() > 0 {
+		input.Configuration = expandResourceGroupsGroupConfigurationItems(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("resource_query"); ok && len(v.([]interface{})) > 0 {
+		input.ResourceQuery = extractResourceGroupResourceQuery(v.([]interface{}))
 	}
 
 	res, err := conn.CreateGroup(&input)
@@ -98,6 +145,12 @@ func resourceAwsResourceGroupsGroupCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(aws.StringValue(res.Group.Name))
 
+	if input.Configuration != nil {
+		if _, err := waiter.GroupConfigurationUpdated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for resource group (%s) configuration update: %w", d.Id(), err)
+		}
+	}
+
 	return resourceAwsResourceGroupsGroupRead(d, meta)
 }
 
@@ -125,19 +178,32 @@ func resourceAwsResourceGroupsGroupRead(d *schema.ResourceData, meta interface{}
 	d.Set("description", g.Group.Description)
 	d.Set("arn", arn)
 
+	groupCfg, err := finder.GroupConfigurationByGroupName(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading configuration for resource group (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenResourceGroupsGroupConfigurationItems(groupCfg.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
 	q, err := conn.GetGroupQuery(&resourcegroups.GetGroupQueryInput{
 		GroupName: aws.String(d.Id()),
 	})
 
-	if err != nil {
+	// Groups created from a service configuration alone have no resource query.
+	if isAWSErr(err, resourcegroups.ErrCodeBadRequestException, "") && len(groupCfg.Configuration) > 0 {
+		d.Set("resource_query", nil)
+	} else if err != nil {
 		return fmt.Errorf("error reading resource query for resource group (%s): %s", d.Id(), err)
-	}
-
-	resultQuery := map[string]interface{}{}
-	resultQuery["query"] = aws.StringValue(q.GroupQuery.ResourceQuery.Query)
-	resultQuery["type"] = aws.StringValue(q.GroupQuery.ResourceQuery.Type)
-	if err := d.Set("resource_query", []map[string]interface{}{resultQuery}); err != nil {
-		return fmt.Errorf("error setting resource_query: %s", err)
+	} else {
+		resultQuery := map[string]interface{}{}
+		resultQuery["query"] = aws.StringValue(q.GroupQuery.ResourceQuery.Query)
+		resultQuery["type"] = aws.StringValue(q.GroupQuery.ResourceQuery.Type)
+		if err := d.Set("resource_query", []map[string]interface{}{resultQuery}); err != nil {
+			return fmt.Errorf("error setting resource_query: %s", err)
+		}
 	}
 
 	tags, err := keyvaluetags.ResourcegroupsListTags(conn, arn)
@@ -173,7 +239,23 @@ func resourceAwsResourceGroupsGroupUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("resource_query") {
+	if d.HasChange("configuration") {
+		input := resourcegroups.PutGroupConfigurationInput{
+			Configuration: expandResourceGroupsGroupConfigurationItems(d.Get("configuration").(*schema.Set).List()),
+			Group:         aws.String(d.Id()),
+		}
+
+		_, err := conn.PutGroupConfiguration(&input)
+		if err != nil {
+			return fmt.Errorf("error updating configuration for resource group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.GroupConfigurationUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for resource group (%s) configuration update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("resource_query") && len(d.Get("resource_query").([]interface{})) > 0 {
 		input := resourcegroups.UpdateGroupQueryInput{
 			GroupName:     aws.String(d.Id()),
 			ResourceQuery: extractResourceGroupResourceQuery(d.Get("resource_query").([]interface{})),
@@ -209,3 +291,72 @@ func resourceAwsResourceGroupsGroupDelete(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+func expandResourceGroupsGroupConfigurationItems(tfList []interface{}) []*resourcegroups.GroupConfigurationItem {
+	apiObjects := make([]*resourcegroups.GroupConfigurationItem, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &resourcegroups.GroupConfigurationItem{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["parameters"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfParamRaw := range v.List() {
+				tfParam, ok := tfParamRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.Parameters = append(apiObject.Parameters, &resourcegroups.GroupConfigurationParameter{
+					Name:   aws.String(tfParam["name"].(string)),
+					Values: expandStringList(tfParam["values"].([]interface{})),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenResourceGroupsGroupConfigurationItems(apiObjects []*resourcegroups.GroupConfigurationItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var tfParams []interface{}
+
+		for _, param := range apiObject.Parameters {
+			if param == nil {
+				continue
+			}
+
+			tfParams = append(tfParams, map[string]interface{}{
+				"name":   aws.StringValue(param.Name),
+				"values": aws.StringValueSlice(param.Values),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameters": tfParams,
+			"type":       aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
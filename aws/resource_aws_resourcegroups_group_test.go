@@ -111,6 +111,45 @@ func TestAccAWSResourceGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSResourceGroup_Configuration(t *testing.T) {
+	var v resourcegroups.Group
+	resourceName := "aws_resourcegroups_group.test"
+	n := fmt.Sprintf("test-group-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, resourcegroups.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceGroupConfigConfiguration(n),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"type":         "AWS::EC2::CapacityReservationPool",
+						"parameters.#": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"type":                  "AWS::ResourceGroups::Generic",
+						"parameters.#":          "1",
+						"parameters.0.name":     "allowed-resource-types",
+						"parameters.0.values.#": "1",
+						"parameters.0.values.0": "AWS::EC2::CapacityReservation",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSResourceGroupExists(n string, v *resourcegroups.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -238,3 +277,24 @@ JSON
 }
 `, rName, desc, query, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccAWSResourceGroupConfigConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::EC2::CapacityReservationPool"
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name   = "allowed-resource-types"
+      values = ["AWS::EC2::CapacityReservation"]
+    }
+  }
+}
+`, rName)
+}
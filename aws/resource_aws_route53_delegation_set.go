@@ -19,7 +19,7 @@ func resourceAwsRoute53DelegationSet() *schema.Resource {
 		Read:   resourceAwsRoute53DelegationSetRead,
 		Delete: resourceAwsRoute53DelegationSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("delegationset"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -8,11 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch/finder"
 )
 
 func resourceAwsRoute53HealthCheck() *schema.Resource {
@@ -22,10 +26,65 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 		Update: resourceAwsRoute53HealthCheckUpdate,
 		Delete: resourceAwsRoute53HealthCheckDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("healthcheck"),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"alarm": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"cloudwatch_alarm_name", "cloudwatch_alarm_region"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudwatch.ComparisonOperator_Values(), false),
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"evaluation_periods": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(10),
+						},
+						"statistic": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudwatch.StatisticAverage,
+							ValidateFunc: validation.StringInSlice(cloudwatch.Statistic_Values(), false),
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -159,12 +218,60 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 			"tags_all": tagsSchemaComputed(),
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsRoute53HealthCheckCustomizeDiffAlarm,
+			SetTagsDiff,
+		),
 	}
 }
 
+func resourceAwsRoute53HealthCheckCustomizeDiffAlarm(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	t := strings.ToUpper(diff.Get("type").(string))
+
+	if v, ok := diff.GetOk("alarm"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if t != route53.HealthCheckTypeCloudwatchMetric {
+			return fmt.Errorf("alarm can only be configured for %s health checks, got: %s", route53.HealthCheckTypeCloudwatchMetric, t)
+		}
+
+		return nil
+	}
+
+	// Removing the alarm block deletes the managed alarm, so the health check
+	// must be pointed at another alarm rather than left referencing a deleted one.
+	if o, _ := diff.GetChange("alarm"); diff.Id() != "" && len(o.([]interface{})) > 0 && o.([]interface{})[0] != nil {
+		if t == route53.HealthCheckTypeCloudwatchMetric && diff.Get("cloudwatch_alarm_name").(string) == "" {
+			return fmt.Errorf("cloudwatch_alarm_name must be set when alarm is removed from a %s health check", route53.HealthCheckTypeCloudwatchMetric)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).r53conn
+	cloudwatchconn := meta.(*AWSClient).cloudwatchconn
+
+	var oldAlarmName string
+
+	if d.HasChange("alarm") {
+		o, n := d.GetChange("alarm")
+
+		if v, ok := o.([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			oldAlarmName = v[0].(map[string]interface{})["name"].(string)
+		}
+
+		if v, ok := n.([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			input := expandRoute53HealthCheckAlarm(v[0].(map[string]interface{}))
+
+			if _, err := cloudwatchconn.PutMetricAlarm(input); err != nil {
+				return fmt.Errorf("error updating Route53 Health Check (%s) CloudWatch Metric Alarm (%s): %w", d.Id(), aws.StringValue(input.AlarmName), err)
+			}
+
+			if oldAlarmName == aws.StringValue(input.AlarmName) {
+				oldAlarmName = ""
+			}
+		}
+	}
 
 	updateHealthCheck := &route53.UpdateHealthCheckInput{
 		HealthCheckId: aws.String(d.Id()),
@@ -202,12 +309,17 @@ func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{
 		updateHealthCheck.SearchString = aws.String(d.Get("search_string").(string))
 	}
 
-	if d.HasChanges("cloudwatch_alarm_name", "cloudwatch_alarm_region") {
+	if d.HasChanges("alarm", "cloudwatch_alarm_name", "cloudwatch_alarm_region") {
 		cloudwatchAlarm := &route53.AlarmIdentifier{
 			Name:   aws.String(d.Get("cloudwatch_alarm_name").(string)),
 			Region: aws.String(d.Get("cloudwatch_alarm_region").(string)),
 		}
 
+		if v, ok := d.GetOk("alarm"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			cloudwatchAlarm.Name = aws.String(v.([]interface{})[0].(map[string]interface{})["name"].(string))
+			cloudwatchAlarm.Region = aws.String(meta.(*AWSClient).region)
+		}
+
 		updateHealthCheck.AlarmIdentifier = cloudwatchAlarm
 	}
 
@@ -232,6 +344,12 @@ func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if oldAlarmName != "" {
+		if err := deleteRoute53HealthCheckAlarm(cloudwatchconn, oldAlarmName); err != nil {
+			return fmt.Errorf("error updating Route53 Health Check (%s): %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -304,15 +422,33 @@ func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	var alarmName string
+
 	if *healthConfig.Type == route53.HealthCheckTypeCloudwatchMetric {
 		cloudwatchAlarmIdentifier := &route53.AlarmIdentifier{}
 
-		if v, ok := d.GetOk("cloudwatch_alarm_name"); ok {
-			cloudwatchAlarmIdentifier.Name = aws.String(v.(string))
+		if v, ok := d.GetOk("alarm"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := expandRoute53HealthCheckAlarm(v.([]interface{})[0].(map[string]interface{}))
+
+			log.Printf("[DEBUG] Creating Route53 Health Check CloudWatch Metric Alarm: %s", input)
+			if _, err := meta.(*AWSClient).cloudwatchconn.PutMetricAlarm(input); err != nil {
+				return fmt.Errorf("error creating Route53 Health Check CloudWatch Metric Alarm (%s): %w", aws.StringValue(input.AlarmName), err)
+			}
+
+			alarmName = aws.StringValue(input.AlarmName)
 		}
 
-		if v, ok := d.GetOk("cloudwatch_alarm_region"); ok {
-			cloudwatchAlarmIdentifier.Region = aws.String(v.(string))
+		if alarmName != "" {
+			cloudwatchAlarmIdentifier.Name = aws.String(alarmName)
+			cloudwatchAlarmIdentifier.Region = aws.String(meta.(*AWSClient).region)
+		} else {
+			if v, ok := d.GetOk("cloudwatch_alarm_name"); ok {
+				cloudwatchAlarmIdentifier.Name = aws.String(v.(string))
+			}
+
+			if v, ok := d.GetOk("cloudwatch_alarm_region"); ok {
+				cloudwatchAlarmIdentifier.Region = aws.String(v.(string))
+			}
 		}
 
 		healthConfig.AlarmIdentifier = cloudwatchAlarmIdentifier
@@ -343,6 +479,13 @@ func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{
 	resp, err := conn.CreateHealthCheck(input)
 
 	if err != nil {
+		// Don't leave an orphaned alarm behind if the health check couldn't be created.
+		if alarmName != "" {
+			if err := deleteRoute53HealthCheckAlarm(meta.(*AWSClient).cloudwatchconn, alarmName); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+		}
+
 		return err
 	}
 
@@ -397,9 +540,39 @@ func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("regions", flattenStringList(updated.Regions))
 
+	var alarmName string
+
+	if v, ok := d.GetOk("alarm"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		alarmName = v.([]interface{})[0].(map[string]interface{})["name"].(string)
+	}
+
 	if updated.AlarmIdentifier != nil {
-		d.Set("cloudwatch_alarm_name", updated.AlarmIdentifier.Name)
-		d.Set("cloudwatch_alarm_region", updated.AlarmIdentifier.Region)
+		name, region := updated.AlarmIdentifier.Name, updated.AlarmIdentifier.Region
+
+		// A managed alarm is configured through the alarm block, so the plain
+		// arguments stay empty unless the health check points at another alarm.
+		if alarmName != "" && aws.StringValue(name) == alarmName {
+			name, region = nil, nil
+		}
+
+		d.Set("cloudwatch_alarm_name", name)
+		d.Set("cloudwatch_alarm_region", region)
+	}
+
+	if alarmName != "" {
+		alarm, err := finder.MetricAlarmByName(meta.(*AWSClient).cloudwatchconn, alarmName)
+
+		if err != nil {
+			return fmt.Errorf("error reading Route53 Health Check (%s) CloudWatch Metric Alarm (%s): %w", d.Id(), alarmName, err)
+		}
+
+		if alarm == nil {
+			log.Printf("[WARN] CloudWatch alarm (%s) for Route 53 Health Check (%s) not found, clearing alarm", alarmName, d.Id())
+		}
+
+		if err := d.Set("alarm", flattenRoute53HealthCheckAlarm(alarm)); err != nil {
+			return fmt.Errorf("error setting alarm: %w", err)
+		}
 	}
 
 	tags, err := keyvaluetags.Route53ListTags(conn, d.Id(), route53.TagResourceTypeHealthcheck)
@@ -442,5 +615,74 @@ func resourceAwsRoute53HealthCheckDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("error deleting Route53 Health Check (%s): %w", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("alarm"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		name := v.([]interface{})[0].(map[string]interface{})["name"].(string)
+
+		if err := deleteRoute53HealthCheckAlarm(meta.(*AWSClient).cloudwatchconn, name); err != nil {
+			return fmt.Errorf("error deleting Route53 Health Check (%s): %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func deleteRoute53HealthCheckAlarm(conn *cloudwatch.CloudWatch, name string) error {
+	log.Printf("[DEBUG] Deleting Route53 Health Check CloudWatch Metric Alarm: %s", name)
+	_, err := conn.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{
+		AlarmNames: aws.StringSlice([]string{name}),
+	})
+
+	if isAWSErr(err, cloudwatch.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Metric Alarm (%s): %w", name, err)
+	}
+
 	return nil
 }
+
+func expandRoute53HealthCheckAlarm(tfMap map[string]interface{}) *cloudwatch.PutMetricAlarmInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatch.PutMetricAlarmInput{
+		AlarmDescription:   aws.String("Managed by Terraform as part of a Route53 health check"),
+		AlarmName:          aws.String(tfMap["name"].(string)),
+		ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+		EvaluationPeriods:  aws.Int64(int64(tfMap["evaluation_periods"].(int))),
+		MetricName:         aws.String(tfMap["metric_name"].(string)),
+		Namespace:          aws.String(tfMap["namespace"].(string)),
+		Period:             aws.Int64(int64(tfMap["period"].(int))),
+		Statistic:          aws.String(tfMap["statistic"].(string)),
+		Threshold:          aws.Float64(tfMap["threshold"].(float64)),
+	}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Dimensions = expandAwsCloudWatchMetricAlarmDimensions(v)
+	}
+
+	return apiObject
+}
+
+func flattenRoute53HealthCheckAlarm(apiObject *cloudwatch.MetricAlarm) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison_operator": aws.StringValue(apiObject.ComparisonOperator),
+		"dimensions":          flattenAwsCloudWatchMetricAlarmDimensions(apiObject.Dimensions),
+		"evaluation_periods":  aws.Int64Value(apiObject.EvaluationPeriods),
+		"metric_name":         aws.StringValue(apiObject.MetricName),
+		"name":                aws.StringValue(apiObject.AlarmName),
+		"namespace":           aws.StringValue(apiObject.Namespace),
+		"period":              aws.Int64Value(apiObject.Period),
+		"statistic":           aws.StringValue(apiObject.Statistic),
+		"threshold":           aws.Float64Value(apiObject.Threshold),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -37,6 +38,12 @@ func TestAccAWSRoute53HealthCheck_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFuncARN(resourceName),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccRoute53HealthCheckConfigUpdate,
 				Check: resource.ComposeTestCheckFunc(
@@ -254,6 +261,84 @@ func TestAccAWSRoute53HealthCheck_CloudWatchAlarmCheck(t *testing.T) {
 	})
 }
 
+func TestAccAWSRoute53HealthCheck_Alarm(t *testing.T) {
+	var check route53.HealthCheck
+	resourceName := "aws_route53_health_check.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, route53.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53HealthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53HealthCheckConfigAlarm(rName, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53HealthCheckExists(resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.statistic", "Average"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_region", ""),
+				),
+			},
+			{
+				Config: testAccRoute53HealthCheckConfigAlarm(rNameUpdated, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53HealthCheckExists(resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.threshold", "90"),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_name", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"alarm", "cloudwatch_alarm_name", "cloudwatch_alarm_region"},
+			},
+			{
+				Config: testAccRoute53HealthCheckConfigAlarmRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53HealthCheckExists(resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_alarm_name", "aws_cloudwatch_metric_alarm.test", "alarm_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_alarm_region", "data.aws_region.current", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53HealthCheck_Alarm_removeWithoutAlarmName(t *testing.T) {
+	var check route53.HealthCheck
+	resourceName := "aws_route53_health_check.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, route53.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53HealthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53HealthCheckConfigAlarm(rName, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53HealthCheckExists(resourceName, &check),
+				),
+			},
+			{
+				Config:      testAccRoute53HealthCheckConfigAlarmRemovedWithoutAlarmName(),
+				ExpectError: regexp.MustCompile(`cloudwatch_alarm_name must be set when alarm is removed`),
+			},
+		},
+	})
+}
+
 func TestAccAWSRoute53HealthCheck_withSNI(t *testing.T) {
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
@@ -588,6 +673,60 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+func testAccRoute53HealthCheckConfigAlarm(rName string, threshold int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  insufficient_data_health_status = "Healthy"
+
+  alarm {
+    name                = %[1]q
+    comparison_operator = "GreaterThanOrEqualToThreshold"
+    evaluation_periods  = 2
+    metric_name         = "CPUUtilization"
+    namespace           = "AWS/EC2"
+    period              = 120
+    threshold           = %[2]d
+  }
+}
+`, rName, threshold)
+}
+
+func testAccRoute53HealthCheckConfigAlarmRemoved(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  insufficient_data_health_status = "Healthy"
+  cloudwatch_alarm_name           = aws_cloudwatch_metric_alarm.test.alarm_name
+  cloudwatch_alarm_region         = data.aws_region.current.name
+}
+`, rName)
+}
+
+func testAccRoute53HealthCheckConfigAlarmRemovedWithoutAlarmName() string {
+	return `
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  insufficient_data_health_status = "Healthy"
+}
+`
+}
+
 const testAccRoute53HealthCheckConfigWithSearchString = `
 resource "aws_route53_health_check" "test" {
   fqdn               = "dev.example.com"
@@ -16,7 +16,7 @@ func resourceAwsRoute53QueryLog() *schema.Resource {
 		Read:   resourceAwsRoute53QueryLogRead,
 		Delete: resourceAwsRoute53QueryLogDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("queryloggingconfig"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -26,7 +26,7 @@ func resourceAwsRoute53ResolverEndpoint() *schema.Resource {
 		Update: resourceAwsRoute53ResolverEndpointUpdate,
 		Delete: resourceAwsRoute53ResolverEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("resolver-endpoint"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -20,7 +20,7 @@ func resourceAwsRoute53ResolverFirewallDomainList() *schema.Resource {
 		Update: resourceAwsRoute53ResolverFirewallDomainListUpdate,
 		Delete: resourceAwsRoute53ResolverFirewallDomainListDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("firewall-domain-list"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -19,7 +19,7 @@ func resourceAwsRoute53ResolverFirewallRuleGroup() *schema.Resource {
 		Update: resourceAwsRoute53ResolverFirewallRuleGroupUpdate,
 		Delete: resourceAwsRoute53ResolverFirewallRuleGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("firewall-rule-group"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -21,7 +21,7 @@ func resourceAwsRoute53ResolverFirewallRuleGroupAssociation() *schema.Resource {
 		Update: resourceAwsRoute53ResolverFirewallRuleGroupAssociationUpdate,
 		Delete: resourceAwsRoute53ResolverFirewallRuleGroupAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("firewall-rule-group-association"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -20,7 +20,7 @@ func resourceAwsRoute53ResolverQueryLogConfig() *schema.Resource {
 		Update: resourceAwsRoute53ResolverQueryLogConfigUpdate,
 		Delete: resourceAwsRoute53ResolverQueryLogConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("resolver-query-log-config"),
 		},
 
 		Schema: map[string]*schema.Schema{
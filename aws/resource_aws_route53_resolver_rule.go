@@ -32,7 +32,7 @@ func resourceAwsRoute53ResolverRule() *schema.Resource {
 			SetTagsDiff,
 		),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("resolver-rule"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -30,7 +30,7 @@ func resourceAwsRoute53Zone() *schema.Resource {
 		Update: resourceAwsRoute53ZoneUpdate,
 		Delete: resourceAwsRoute53ZoneDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("hostedzone"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -20,7 +20,7 @@ func resourceAwsRoute53RecoveryReadinessCell() *schema.Resource {
 		Update: resourceAwsRoute53RecoveryReadinessCellUpdate,
 		Delete: resourceAwsRoute53RecoveryReadinessCellDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("cell"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -37,6 +37,12 @@ func TestAccAwsRoute53RecoveryReadinessCell_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFuncARN(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -20,7 +20,7 @@ func resourceAwsRoute53RecoveryReadinessReadinessCheck() *schema.Resource {
 		Update: resourceAwsRoute53RecoveryReadinessReadinessCheckUpdate,
 		Delete: resourceAwsRoute53RecoveryReadinessReadinessCheckDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("readiness-check"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
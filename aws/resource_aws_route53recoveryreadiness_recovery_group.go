@@ -20,7 +20,7 @@ func resourceAwsRoute53RecoveryReadinessRecoveryGroup() *schema.Resource {
 		Update: resourceAwsRoute53RecoveryReadinessRecoveryGroupUpdate,
 		Delete: resourceAwsRoute53RecoveryReadinessRecoveryGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("recovery-group"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
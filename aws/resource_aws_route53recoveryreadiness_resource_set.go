@@ -20,7 +20,7 @@ func resourceAwsRoute53RecoveryReadinessResourceSet() *schema.Resource {
 		Update: resourceAwsRoute53RecoveryReadinessResourceSetUpdate,
 		Delete: resourceAwsRoute53RecoveryReadinessResourceSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStatePassthroughARN("resource-set"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -45,6 +45,12 @@ func TestAccAwsRoute53RecoveryReadinessResourceSet_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFuncARN(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
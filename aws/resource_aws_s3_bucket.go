@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -468,6 +470,46 @@ func resourceAwsS3Bucket() *schema.Resource {
 														},
 													},
 												},
+												"metrics": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Default:      15,
+																ValidateFunc: validation.IntBetween(10, 15),
+															},
+															"status": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(s3.MetricsStatus_Values(), false),
+															},
+														},
+													},
+												},
+												"replication_time": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Default:      15,
+																ValidateFunc: validation.IntBetween(15, 15),
+															},
+															"status": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(s3.ReplicationTimeStatus_Values(), false),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -478,6 +520,20 @@ func resourceAwsS3Bucket() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"replica_modifications": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enabled": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
 												"sse_kms_encrypted_objects": {
 													Type:     schema.TypeList,
 													Optional: true,
@@ -635,7 +691,10 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"tags_all": tagsSchemaComputed(),
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsS3BucketCustomizeDiffReplicaModifications,
+			SetTagsDiff,
+		),
 	}
 }
 
@@ -1116,112 +1175,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 		for _, lifecycleRule := range lifecycle.Rules {
 			log.Printf("[DEBUG] S3 bucket: %s, read lifecycle rule: %v", d.Id(), lifecycleRule)
-			rule := make(map[string]interface{})
-
-			// ID
-			if lifecycleRule.ID != nil && aws.StringValue(lifecycleRule.ID) != "" {
-				rule["id"] = aws.StringValue(lifecycleRule.ID)
-			}
-			filter := lifecycleRule.Filter
-			if filter != nil {
-				if filter.And != nil {
-					// Prefix
-					if filter.And.Prefix != nil && aws.StringValue(filter.And.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.And.Prefix)
-					}
-					// Tag
-					if len(filter.And.Tags) > 0 {
-						rule["tags"] = keyvaluetags.S3KeyValueTags(filter.And.Tags).IgnoreAws().Map()
-					}
-				} else {
-					// Prefix
-					if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.Prefix)
-					}
-					// Tag
-					if filter.Tag != nil {
-						rule["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{filter.Tag}).IgnoreAws().Map()
-					}
-				}
-			} else {
-				if lifecycleRule.Prefix != nil {
-					rule["prefix"] = aws.StringValue(lifecycleRule.Prefix)
-				}
-			}
-
-			// Enabled
-			if lifecycleRule.Status != nil {
-				if aws.StringValue(lifecycleRule.Status) == s3.ExpirationStatusEnabled {
-					rule["enabled"] = true
-				} else {
-					rule["enabled"] = false
-				}
-			}
-
-			// AbortIncompleteMultipartUploadDays
-			if lifecycleRule.AbortIncompleteMultipartUpload != nil {
-				if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
-					rule["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
-				}
-			}
-
-			// expiration
-			if lifecycleRule.Expiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.Expiration.Date != nil {
-					e["date"] = (aws.TimeValue(lifecycleRule.Expiration.Date)).Format("2006-01-02")
-				}
-				if lifecycleRule.Expiration.Days != nil {
-					e["days"] = int(aws.Int64Value(lifecycleRule.Expiration.Days))
-				}
-				if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
-					e["expired_object_delete_marker"] = aws.BoolValue(lifecycleRule.Expiration.ExpiredObjectDeleteMarker)
-				}
-				rule["expiration"] = []interface{}{e}
-			}
-			// noncurrent_version_expiration
-			if lifecycleRule.NoncurrentVersionExpiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
-					e["days"] = int(aws.Int64Value(lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays))
-				}
-				rule["noncurrent_version_expiration"] = []interface{}{e}
-			}
-			//// transition
-			if len(lifecycleRule.Transitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
-				for _, v := range lifecycleRule.Transitions {
-					t := make(map[string]interface{})
-					if v.Date != nil {
-						t["date"] = (aws.TimeValue(v.Date)).Format("2006-01-02")
-					}
-					if v.Days != nil {
-						t["days"] = int(aws.Int64Value(v.Days))
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = aws.StringValue(v.StorageClass)
-					}
-					transitions = append(transitions, t)
-				}
-				rule["transition"] = schema.NewSet(transitionHash, transitions)
-			}
-			// noncurrent_version_transition
-			if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
-				for _, v := range lifecycleRule.NoncurrentVersionTransitions {
-					t := make(map[string]interface{})
-					if v.NoncurrentDays != nil {
-						t["days"] = int(aws.Int64Value(v.NoncurrentDays))
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = aws.StringValue(v.StorageClass)
-					}
-					transitions = append(transitions, t)
-				}
-				rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
-			}
-
-			lifecycleRules = append(lifecycleRules, rule)
+			lifecycleRules = append(lifecycleRules, flattenAwsS3BucketLifecycleRule(lifecycleRule))
 		}
 	}
 	if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
@@ -1997,6 +1951,48 @@ func resourceAwsS3BucketObjectLockConfigurationUpdate(s3conn *s3.S3, d *schema.R
 	return nil
 }
 
+// resourceAwsS3BucketCustomizeDiffReplicaModifications fails the plan when replica modification
+// sync is enabled on a bucket that does not have versioning enabled.
+func resourceAwsS3BucketCustomizeDiffReplicaModifications(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if v, ok := diff.Get("versioning").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v[0].(map[string]interface{})["enabled"].(bool) {
+			return nil
+		}
+	}
+
+	replicationConfiguration, ok := diff.Get("replication_configuration").([]interface{})
+
+	if !ok || len(replicationConfiguration) == 0 || replicationConfiguration[0] == nil {
+		return nil
+	}
+
+	for _, tfMapRaw := range replicationConfiguration[0].(map[string]interface{})["rules"].(*schema.Set).List() {
+		rule, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		ssc, ok := rule["source_selection_criteria"].([]interface{})
+
+		if !ok || len(ssc) == 0 || ssc[0] == nil {
+			continue
+		}
+
+		replicaModifications, ok := ssc[0].(map[string]interface{})["replica_modifications"].([]interface{})
+
+		if !ok || len(replicaModifications) == 0 || replicaModifications[0] == nil {
+			continue
+		}
+
+		if replicaModifications[0].(map[string]interface{})["enabled"].(bool) {
+			return errors.New("versioning must be enabled to allow S3 bucket replica modification sync")
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
@@ -2075,6 +2071,26 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 					ruleAclTranslation.Owner = aws.String(aclTranslationValues["owner"].(string))
 					ruleDestination.AccessControlTranslation = ruleAclTranslation
 				}
+
+				if metrics, ok := bd["metrics"].([]interface{}); ok && len(metrics) > 0 && metrics[0] != nil {
+					metricsValues := metrics[0].(map[string]interface{})
+					ruleDestination.Metrics = &s3.Metrics{
+						Status: aws.String(metricsValues["status"].(string)),
+						EventThreshold: &s3.ReplicationTimeValue{
+							Minutes: aws.Int64(int64(metricsValues["minutes"].(int))),
+						},
+					}
+				}
+
+				if rtc, ok := bd["replication_time"].([]interface{}); ok && len(rtc) > 0 && rtc[0] != nil {
+					rtcValues := rtc[0].(map[string]interface{})
+					ruleDestination.ReplicationTime = &s3.ReplicationTime{
+						Status: aws.String(rtcValues["status"].(string)),
+						Time: &s3.ReplicationTimeValue{
+							Minutes: aws.Int64(int64(rtcValues["minutes"].(int))),
+						},
+					}
+				}
 			}
 		}
 		rcRule.Destination = ruleDestination
@@ -2095,6 +2111,18 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 						ruleSsc.SseKmsEncryptedObjects = sseKmsEncryptedObjects
 					}
 				}
+				if replicaModifications, ok := sscValues["replica_modifications"].([]interface{}); ok && len(replicaModifications) > 0 {
+					if replicaModifications[0] != nil {
+						replicaModificationsValues := replicaModifications[0].(map[string]interface{})
+						ruleReplicaModifications := &s3.ReplicaModifications{}
+						if replicaModificationsValues["enabled"].(bool) {
+							ruleReplicaModifications.Status = aws.String(s3.ReplicaModificationsStatusEnabled)
+						} else {
+							ruleReplicaModifications.Status = aws.String(s3.ReplicaModificationsStatusDisabled)
+						}
+						ruleSsc.ReplicaModifications = ruleReplicaModifications
+					}
+				}
 				rcRule.SourceSelectionCriteria = ruleSsc
 			}
 		}
@@ -2337,6 +2365,118 @@ func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionCon
 	return encryptionConfiguration
 }
 
+func flattenAwsS3BucketLifecycleRule(lifecycleRule *s3.LifecycleRule) map[string]interface{} {
+	rule := make(map[string]interface{})
+
+	// ID
+	if lifecycleRule.ID != nil && aws.StringValue(lifecycleRule.ID) != "" {
+		rule["id"] = aws.StringValue(lifecycleRule.ID)
+	}
+	// AWS may return an empty filter alongside the legacy top-level prefix
+	// for rules created via the V1 lifecycle API, so only read from the
+	// filter when it actually carries a predicate.
+	filter := lifecycleRule.Filter
+	if filter != nil && (filter.And != nil || filter.Prefix != nil || filter.Tag != nil) {
+		if filter.And != nil {
+			// Prefix
+			if filter.And.Prefix != nil && aws.StringValue(filter.And.Prefix) != "" {
+				rule["prefix"] = aws.StringValue(filter.And.Prefix)
+			}
+			// Tag
+			if len(filter.And.Tags) > 0 {
+				rule["tags"] = keyvaluetags.S3KeyValueTags(filter.And.Tags).IgnoreAws().Map()
+			}
+		} else {
+			// Prefix
+			if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
+				rule["prefix"] = aws.StringValue(filter.Prefix)
+			}
+			// Tag
+			if filter.Tag != nil {
+				rule["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{filter.Tag}).IgnoreAws().Map()
+			}
+		}
+	} else {
+		if lifecycleRule.Prefix != nil {
+			rule["prefix"] = aws.StringValue(lifecycleRule.Prefix)
+		}
+	}
+
+	// Enabled
+	if lifecycleRule.Status != nil {
+		if aws.StringValue(lifecycleRule.Status) == s3.ExpirationStatusEnabled {
+			rule["enabled"] = true
+		} else {
+			rule["enabled"] = false
+		}
+	}
+
+	// AbortIncompleteMultipartUploadDays
+	if lifecycleRule.AbortIncompleteMultipartUpload != nil {
+		if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+			rule["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
+		}
+	}
+
+	// expiration
+	if lifecycleRule.Expiration != nil {
+		e := make(map[string]interface{})
+		if lifecycleRule.Expiration.Date != nil {
+			e["date"] = (aws.TimeValue(lifecycleRule.Expiration.Date)).Format("2006-01-02")
+		}
+		if lifecycleRule.Expiration.Days != nil {
+			e["days"] = int(aws.Int64Value(lifecycleRule.Expiration.Days))
+		}
+		if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
+			e["expired_object_delete_marker"] = aws.BoolValue(lifecycleRule.Expiration.ExpiredObjectDeleteMarker)
+		}
+		rule["expiration"] = []interface{}{e}
+	}
+	// noncurrent_version_expiration
+	if lifecycleRule.NoncurrentVersionExpiration != nil {
+		e := make(map[string]interface{})
+		if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+			e["days"] = int(aws.Int64Value(lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays))
+		}
+		rule["noncurrent_version_expiration"] = []interface{}{e}
+	}
+	//// transition
+	if len(lifecycleRule.Transitions) > 0 {
+		transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
+		for _, v := range lifecycleRule.Transitions {
+			t := make(map[string]interface{})
+			if v.Date != nil {
+				t["date"] = (aws.TimeValue(v.Date)).Format("2006-01-02")
+			}
+			if v.Days != nil {
+				t["days"] = int(aws.Int64Value(v.Days))
+			}
+			if v.StorageClass != nil {
+				t["storage_class"] = aws.StringValue(v.StorageClass)
+			}
+			transitions = append(transitions, t)
+		}
+		rule["transition"] = schema.NewSet(transitionHash, transitions)
+	}
+	// noncurrent_version_transition
+	if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
+		transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
+		for _, v := range lifecycleRule.NoncurrentVersionTransitions {
+			t := make(map[string]interface{})
+			if v.NoncurrentDays != nil {
+				t["days"] = int(aws.Int64Value(v.NoncurrentDays))
+			}
+			if v.StorageClass != nil {
+				t["storage_class"] = aws.StringValue(v.StorageClass)
+			}
+			transitions = append(transitions, t)
+		}
+		rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
+	}
+
+	return rule
+}
+
 func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration) []map[string]interface{} {
 	replication_configuration := make([]map[string]interface{}, 0, 1)
 
@@ -2375,6 +2515,24 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 				}
 				rd["access_control_translation"] = []interface{}{rdt}
 			}
+			if v.Destination.Metrics != nil {
+				rdm := map[string]interface{}{
+					"status": aws.StringValue(v.Destination.Metrics.Status),
+				}
+				if v.Destination.Metrics.EventThreshold != nil {
+					rdm["minutes"] = int(aws.Int64Value(v.Destination.Metrics.EventThreshold.Minutes))
+				}
+				rd["metrics"] = []interface{}{rdm}
+			}
+			if v.Destination.ReplicationTime != nil {
+				rdrt := map[string]interface{}{
+					"status": aws.StringValue(v.Destination.ReplicationTime.Status),
+				}
+				if v.Destination.ReplicationTime.Time != nil {
+					rdrt["minutes"] = int(aws.Int64Value(v.Destination.ReplicationTime.Time.Minutes))
+				}
+				rd["replication_time"] = []interface{}{rdrt}
+			}
 			t["destination"] = []interface{}{rd}
 		}
 
@@ -2398,6 +2556,12 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 				}
 				tssc["sse_kms_encrypted_objects"] = []interface{}{tSseKms}
 			}
+			if vssc.ReplicaModifications != nil {
+				tReplicaModifications := map[string]interface{}{
+					"enabled": aws.StringValue(vssc.ReplicaModifications.Status) == s3.ReplicaModificationsStatusEnabled,
+				}
+				tssc["replica_modifications"] = []interface{}{tReplicaModifications}
+			}
 			t["source_selection_criteria"] = []interface{}{tssc}
 		}
 
@@ -2639,6 +2803,29 @@ func destinationHash(v interface{}) int {
 	if v, ok := m["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		buf.WriteString(fmt.Sprintf("%d-", accessControlTranslationHash(v[0])))
 	}
+	if v, ok := m["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("%d-", replicationStatusMinutesHash(v[0])))
+	}
+	if v, ok := m["replication_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("%d-", replicationStatusMinutesHash(v[0])))
+	}
+	return hashcode.String(buf.String())
+}
+
+func replicationStatusMinutesHash(v interface{}) int {
+	var buf bytes.Buffer
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	if v, ok := m["status"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["minutes"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -2667,6 +2854,9 @@ func sourceSelectionCriteriaHash(v interface{}) int {
 	if v, ok := m["sse_kms_encrypted_objects"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		buf.WriteString(fmt.Sprintf("%d-", sourceSseKmsObjectsHash(v[0])))
 	}
+	if v, ok := m["replica_modifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("replica-modifications-%d-", sourceSseKmsObjectsHash(v[0])))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"crypto/md5"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -189,6 +190,72 @@ func TestAccAWSS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_sourceLargeSinglePart(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	// Without upload_part_size, objects larger than the upload manager's default part size
+	// are still uploaded with a single request and keep an MD5 ETag.
+	data := strings.Repeat("0123456789abcdef", 12*1024*1024/16)
+	source := testAccAWSS3BucketObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfigSource(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, data),
+					resource.TestCheckResourceAttr(resourceName, "etag", fmt.Sprintf("%x", md5.Sum([]byte(data)))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_sourceMultipart(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	// 12 MiB of data uploaded in 5 MiB parts results in a three part upload.
+	data := strings.Repeat("0123456789abcdef", 12*1024*1024/16)
+	source := testAccAWSS3BucketObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfigSourceMultipart(rName, source, 5*1024*1024, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, data),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "upload_concurrency", "upload_part_size"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_content(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -1675,6 +1742,23 @@ resource "aws_s3_bucket_object" "object" {
 `, rName, source)
 }
 
+func testAccAWSS3BucketObjectConfigSourceMultipart(rName string, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  content_type       = "binary/octet-stream"
+  upload_part_size   = %[3]d
+  upload_concurrency = %[4]d
+}
+`, rName, source, partSize, concurrency)
+}
+
 func testAccAWSS3BucketObjectConfig_withContentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1409,6 +1409,66 @@ func TestAccAWSS3Bucket_Manage_lifecycleBasic(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_Manage_lifecycleRuleLegacyPrefix(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
+	resourceName := "aws_s3_bucket.bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigWithLifecycleLegacyPrefix(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.prefix", "path1/"),
+				),
+			},
+			{
+				// Replace the rule using the V1 lifecycle API, which stores a
+				// top-level Prefix and is read back with an empty Filter.
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+					_, err := conn.PutBucketLifecycle(&s3.PutBucketLifecycleInput{
+						Bucket: aws.String(bucketName),
+						LifecycleConfiguration: &s3.LifecycleConfiguration{
+							Rules: []*s3.Rule{
+								{
+									Expiration: &s3.LifecycleExpiration{
+										Days: aws.Int64(30),
+									},
+									ID:     aws.String("id1"),
+									Prefix: aws.String("path1/"),
+									Status: aws.String(s3.ExpirationStatusEnabled),
+								},
+							},
+						},
+					})
+
+					if err != nil {
+						t.Fatalf("error putting S3 Bucket (%s) lifecycle: %s", bucketName, err)
+					}
+				},
+				Config:   testAccAWSS3BucketConfigWithLifecycleLegacyPrefix(bucketName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSS3BucketConfigWithLifecycleLegacyPrefix(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.id", "id1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.prefix", "path1/"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3Bucket_Manage_lifecycleExpireMarkerOnly(t *testing.T) {
 	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
@@ -2350,6 +2410,103 @@ func TestAccAWSS3Bucket_Replication_schemaV2SameRegion(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_Replication_schemaV2SameRegionMetricsAndReplicationTime(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	destinationResourceName := "aws_s3_bucket.destination"
+	rNameDestination := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigSameRegionReplicationWithV2ConfigurationMetricsAndReplicationTime(rName, rNameDestination),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rules.#", "1"),
+					testAccCheckAWSS3BucketExists(destinationResourceName),
+					testAccCheckAWSS3BucketReplicationRules(
+						resourceName,
+						[]*s3.ReplicationRule{
+							{
+								ID: aws.String("testid"),
+								Destination: &s3.Destination{
+									Bucket:       aws.String(fmt.Sprintf("arn:%s:s3:::%s", testAccGetPartition(), rNameDestination)),
+									StorageClass: aws.String(s3.ObjectStorageClassStandard),
+									Metrics: &s3.Metrics{
+										Status: aws.String(s3.MetricsStatusEnabled),
+										EventThreshold: &s3.ReplicationTimeValue{
+											Minutes: aws.Int64(15),
+										},
+									},
+									ReplicationTime: &s3.ReplicationTime{
+										Status: aws.String(s3.ReplicationTimeStatusEnabled),
+										Time: &s3.ReplicationTimeValue{
+											Minutes: aws.Int64(15),
+										},
+									},
+								},
+								SourceSelectionCriteria: &s3.SourceSelectionCriteria{
+									ReplicaModifications: &s3.ReplicaModifications{
+										Status: aws.String(s3.ReplicaModificationsStatusEnabled),
+									},
+								},
+								Status: aws.String(s3.ReplicationRuleStatusEnabled),
+								Filter: &s3.ReplicationRuleFilter{
+									And: &s3.ReplicationRuleAndOperator{
+										Prefix: aws.String("testprefix"),
+										Tags: []*s3.Tag{
+											{
+												Key:   aws.String("testkey"),
+												Value: aws.String("testvalue"),
+											},
+										},
+									},
+								},
+								Priority: aws.Int64(0),
+								DeleteMarkerReplication: &s3.DeleteMarkerReplication{
+									Status: aws.String(s3.DeleteMarkerReplicationStatusEnabled),
+								},
+							},
+						},
+					),
+				),
+			},
+			{
+				Config:            testAccAWSS3BucketConfigSameRegionReplicationWithV2ConfigurationMetricsAndReplicationTime(rName, rNameDestination),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy", "acl"},
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_Replication_expectReplicaModificationsVersioningValidationError(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameDestination := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketConfigSameRegionReplicationWithV2ConfigurationReplicaModificationsNoVersioning(rName, rNameDestination),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`versioning must be enabled to allow S3 bucket replica modification sync`),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3Bucket_Manage_objectLock(t *testing.T) {
 	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.arbitrary"
@@ -2574,6 +2731,67 @@ func TestBucketRegionalDomainName(t *testing.T) {
 	}
 }
 
+func TestFlattenAwsS3BucketLifecycleRule(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *s3.LifecycleRule
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "filter prefix",
+			Input: &s3.LifecycleRule{
+				Filter: &s3.LifecycleRuleFilter{
+					Prefix: aws.String("path1/"),
+				},
+				ID:     aws.String("id1"),
+				Status: aws.String(s3.ExpirationStatusEnabled),
+			},
+			Expected: map[string]interface{}{
+				"enabled": true,
+				"id":      "id1",
+				"prefix":  "path1/",
+			},
+		},
+		{
+			Name: "empty filter with legacy prefix",
+			Input: &s3.LifecycleRule{
+				Filter: &s3.LifecycleRuleFilter{},
+				ID:     aws.String("id1"),
+				Prefix: aws.String("path1/"),
+				Status: aws.String(s3.ExpirationStatusEnabled),
+			},
+			Expected: map[string]interface{}{
+				"enabled": true,
+				"id":      "id1",
+				"prefix":  "path1/",
+			},
+		},
+		{
+			Name: "no filter with legacy prefix",
+			Input: &s3.LifecycleRule{
+				ID:     aws.String("id1"),
+				Prefix: aws.String("path1/"),
+				Status: aws.String(s3.ExpirationStatusDisabled),
+			},
+			Expected: map[string]interface{}{
+				"enabled": false,
+				"id":      "id1",
+				"prefix":  "path1/",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenAwsS3BucketLifecycleRule(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestWebsiteEndpoint(t *testing.T) {
 	// https://docs.aws.amazon.com/AmazonS3/latest/dev/WebsiteEndpoints.html
 	testCases := []struct {
@@ -3918,6 +4136,25 @@ resource "aws_s3_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccAWSS3BucketConfigWithLifecycleLegacyPrefix(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+  acl    = "private"
+
+  lifecycle_rule {
+    id      = "id1"
+    prefix  = "path1/"
+    enabled = true
+
+    expiration {
+      days = 30
+    }
+  }
+}
+`, bucketName)
+}
+
 func testAccAWSS3BucketConfigWithLifecycleExpireMarker(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -4594,6 +4831,108 @@ resource "aws_s3_bucket" "destination" {
 `, rName, rNameDestination))
 }
 
+func testAccAWSS3BucketConfigSameRegionReplicationWithV2ConfigurationMetricsAndReplicationTime(rName, rNameDestination string) string {
+	return composeConfig(testAccAWSS3BucketReplicationConfig_iamPolicy(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  replication_configuration {
+    role = aws_iam_role.test.arn
+
+    rules {
+      id     = "testid"
+      status = "Enabled"
+
+      filter {
+        prefix = "testprefix"
+
+        tags = {
+          testkey = "testvalue"
+        }
+      }
+
+      delete_marker_replication_status = "Enabled"
+
+      source_selection_criteria {
+        replica_modifications {
+          enabled = true
+        }
+      }
+
+      destination {
+        bucket        = aws_s3_bucket.destination.arn
+        storage_class = "STANDARD"
+
+        metrics {
+          status  = "Enabled"
+          minutes = 15
+        }
+
+        replication_time {
+          status  = "Enabled"
+          minutes = 15
+        }
+      }
+    }
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = %[2]q
+
+  versioning {
+    enabled = true
+  }
+}
+`, rName, rNameDestination))
+}
+
+func testAccAWSS3BucketConfigSameRegionReplicationWithV2ConfigurationReplicaModificationsNoVersioning(rName, rNameDestination string) string {
+	return composeConfig(testAccAWSS3BucketReplicationConfig_iamPolicy(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+  acl    = "private"
+
+  replication_configuration {
+    role = aws_iam_role.test.arn
+
+    rules {
+      id     = "testid"
+      status = "Enabled"
+
+      filter {
+        prefix = "testprefix"
+      }
+
+      source_selection_criteria {
+        replica_modifications {
+          enabled = true
+        }
+      }
+
+      destination {
+        bucket        = aws_s3_bucket.destination.arn
+        storage_class = "STANDARD"
+      }
+    }
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = %[2]q
+
+  versioning {
+    enabled = true
+  }
+}
+`, rName, rNameDestination))
+}
+
 func testAccAWSS3BucketConfigReplicationWithV2ConfigurationDeleteMarkerReplicationDisabled(randInt int) string {
 	return testAccAWSS3BucketConfigReplicationBasic(randInt) + fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -71,6 +73,11 @@ func resourceAwsSecretsManagerSecret() *schema.Resource {
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
+			"promote_removed_replicas": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"recovery_window_in_days": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -364,8 +371,39 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
+		removed := os.Difference(ns).List()
+
+		if d.Get("promote_removed_replicas").(bool) {
+			// Only replicas whose region is no longer configured are promoted,
+			// replicas with changed arguments are still replaced.
+			regions := make(map[string]struct{})
+			for _, tfMapRaw := range ns.List() {
+				if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+					regions[tfMap["region"].(string)] = struct{}{}
+				}
+			}
 
-		err := removeSecretsManagerSecretReplicas(conn, d.Id(), os.Difference(ns).List())
+			var changed, promoted []interface{}
+			for _, tfMapRaw := range removed {
+				if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+					if _, ok := regions[tfMap["region"].(string)]; ok {
+						changed = append(changed, tfMap)
+					} else {
+						promoted = append(promoted, tfMap)
+					}
+				}
+			}
+
+			err := promoteSecretsManagerSecretReplicas(conn, d.Id(), promoted)
+
+			if err != nil {
+				return fmt.Errorf("error promoting Secrets Manager Secret replica: %w", err)
+			}
+
+			removed = changed
+		}
+
+		err := removeSecretsManagerSecretReplicas(conn, d.Id(), removed)
 
 		if err != nil {
 			return fmt.Errorf("error deleting Secrets Manager Secret replica: %w", err)
@@ -490,10 +528,18 @@ func resourceAwsSecretsManagerSecretDelete(d *schema.ResourceData, meta interfac
 	conn := meta.(*AWSClient).secretsmanagerconn
 
 	if v, ok := d.GetOk("replica"); ok && v.(*schema.Set).Len() > 0 {
-		err := removeSecretsManagerSecretReplicas(conn, d.Id(), v.(*schema.Set).List())
+		if d.Get("promote_removed_replicas").(bool) {
+			err := promoteSecretsManagerSecretReplicas(conn, d.Id(), v.(*schema.Set).List())
 
-		if err != nil {
-			return fmt.Errorf("error deleting Secrets Manager Secret replica: %w", err)
+			if err != nil {
+				return fmt.Errorf("error promoting Secrets Manager Secret replica: %w", err)
+			}
+		} else {
+			err := removeSecretsManagerSecretReplicas(conn, d.Id(), v.(*schema.Set).List())
+
+			if err != nil {
+				return fmt.Errorf("error deleting Secrets Manager Secret replica: %w", err)
+			}
 		}
 	}
 
@@ -558,6 +604,59 @@ func removeSecretsManagerSecretReplicas(conn *secretsmanager.SecretsManager, id
 	return nil
 }
 
+// promoteSecretsManagerSecretReplicas promotes the replicas to standalone secrets.
+// Promotion is requested in each replica's region, so the primary secret's region does not need to be available.
+func promoteSecretsManagerSecretReplicas(conn *secretsmanager.SecretsManager, id string, tfList []interface{}) error {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	// The ARNs of a secret and its replicas differ only in the region.
+	secretARN, err := arn.Parse(id)
+
+	if err != nil {
+		return fmt.Errorf("error parsing Secrets Manager Secret ARN (%s): %w", id, err)
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		region := tfMap["region"].(string)
+		replicaARN := secretARN
+		replicaARN.Region = region
+
+		// Only the region is overridden, so any configured secretsmanager endpoint is kept.
+		sess, err := session.NewSession(conn.Config.Copy(&aws.Config{
+			Region: aws.String(region),
+		}))
+
+		if err != nil {
+			return fmt.Errorf("error creating AWS session for region (%s): %w", region, err)
+		}
+
+		input := &secretsmanager.StopReplicationToReplicaInput{
+			SecretId: aws.String(replicaARN.String()),
+		}
+
+		log.Printf("[DEBUG] Promoting Secrets Manager Secret Replica: %s", input)
+		_, err = secretsmanager.New(sess).StopReplicationToReplica(input)
+
+		if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error promoting replica in region (%s): %w", region, err)
+		}
+	}
+
+	return nil
+}
+
 func addSecretsManagerSecretReplicas(conn *secretsmanager.SecretsManager, id string, forceOverwrite bool, tfList []interface{}) error {
 	if len(tfList) == 0 {
 		return nil
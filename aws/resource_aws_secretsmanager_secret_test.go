@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -190,6 +191,38 @@ func TestAccAwsSecretsManagerSecret_basicReplica(t *testing.T) {
 	})
 }
 
+func TestAccAwsSecretsManagerSecret_promoteRemovedReplicas(t *testing.T) {
+	var providers []*schema.Provider
+	var secret secretsmanager.DescribeSecretOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccPreCheckAWSSecretsManager(t); testAccMultipleRegionPreCheck(t, 2) },
+		ErrorCheck:        testAccErrorCheck(t, secretsmanager.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesMultipleRegion(&providers, 2),
+		CheckDestroy:      testAccCheckAwsSecretsManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_promoteRemovedReplicas(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "promote_removed_replicas", "true"),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+				),
+			},
+			{
+				Config: testAccAwsSecretsManagerSecretConfig_promoteRemovedReplicas(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSecretsManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "0"),
+					testAccCheckAwsSecretsManagerSecretPromotedReplica(rName, testAccGetAlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsSecretsManagerSecret_overwriteReplica(t *testing.T) {
 	var providers []*schema.Provider
 	var secret secretsmanager.DescribeSecretOutput
@@ -547,6 +580,43 @@ func testAccCheckAwsSecretsManagerSecretDestroy(s *terraform.State) error {
 
 }
 
+// testAccCheckAwsSecretsManagerSecretPromotedReplica verifies that the promoted replica
+// is a standalone secret and then deletes it, as it is no longer managed by Terraform.
+func testAccCheckAwsSecretsManagerSecretPromotedReplica(name, region string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+
+		sess, err := session.NewSession(conn.Config.Copy(&aws.Config{
+			Region: aws.String(region),
+		}))
+
+		if err != nil {
+			return err
+		}
+
+		regionalConn := secretsmanager.New(sess)
+
+		output, err := regionalConn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading promoted Secrets Manager Secret (%s) in region (%s): %w", name, region, err)
+		}
+
+		if v := aws.StringValue(output.PrimaryRegion); v != "" && v != region {
+			return fmt.Errorf("Secrets Manager Secret (%s) in region (%s) is still a replica of region (%s)", name, region, v)
+		}
+
+		_, err = regionalConn.DeleteSecret(&secretsmanager.DeleteSecretInput{
+			ForceDeleteWithoutRecovery: aws.Bool(true),
+			SecretId:                   output.ARN,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckAwsSecretsManagerSecretExists(resourceName string, secret *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -618,6 +688,36 @@ resource "aws_secretsmanager_secret" "test" {
 `, rName))
 }
 
+func testAccAwsSecretsManagerSecretConfig_promoteRemovedReplicas(rName string, replica bool) string {
+	if !replica {
+		return composeConfig(
+			testAccMultipleRegionProviderConfig(2),
+			fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                     = %[1]q
+  promote_removed_replicas = true
+}
+`, rName))
+	}
+
+	return composeConfig(
+		testAccMultipleRegionProviderConfig(2),
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name                     = %[1]q
+  promote_removed_replicas = true
+
+  replica {
+    region = data.aws_region.alternate.name
+  }
+}
+`, rName))
+}
+
 func testAccAwsSecretsManagerSecretConfig_overwriteReplica(rName string, force_overwrite_replica_secret bool) string {
 	return composeConfig(
 		testAccMultipleRegionProviderConfig(3),
@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/shield/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsShieldProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProactiveEngagementPut,
+		Read:   resourceAwsShieldProactiveEngagementRead,
+		Update: resourceAwsShieldProactiveEngagementPut,
+		Delete: resourceAwsShieldProactiveEngagementDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldProactiveEngagementPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	subscription, err := finder.Subscription(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription: %w", err)
+	}
+
+	emergencyContacts := expandShieldEmergencyContacts(d.Get("emergency_contact").([]interface{}))
+
+	// Proactive engagement is initialized exactly once per subscription.
+	// Afterwards, contacts are updated and the feature is enabled or disabled separately.
+	if subscription.ProactiveEngagementStatus == nil {
+		input := &shield.AssociateProactiveEngagementDetailsInput{
+			EmergencyContactList: emergencyContacts,
+		}
+
+		log.Printf("[DEBUG] Associating Shield Proactive Engagement details: %s", input)
+		_, err = conn.AssociateProactiveEngagementDetails(input)
+
+		if err != nil {
+			return fmt.Errorf("error associating Shield Proactive Engagement details: %w", err)
+		}
+	} else if d.Id() == "" || d.HasChange("emergency_contact") {
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: emergencyContacts,
+		}
+
+		log.Printf("[DEBUG] Updating Shield emergency contact settings: %s", input)
+		_, err = conn.UpdateEmergencyContactSettings(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Shield emergency contact settings: %w", err)
+		}
+	}
+
+	if err := updateShieldProactiveEngagementEnabled(conn, d.Get("enabled").(bool)); err != nil {
+		return err
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsShieldProactiveEngagementRead(d, meta)
+}
+
+func resourceAwsShieldProactiveEngagementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	subscription, err := finder.Subscription(conn)
+
+	if err == nil && subscription.ProactiveEngagementStatus == nil {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription: %w", err)
+	}
+
+	emergencyContacts, err := finder.EmergencyContacts(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield emergency contact settings: %w", err)
+	}
+
+	if err := d.Set("emergency_contact", flattenShieldEmergencyContacts(emergencyContacts)); err != nil {
+		return fmt.Errorf("error setting emergency_contact: %w", err)
+	}
+	d.Set("enabled", aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled)
+
+	return nil
+}
+
+func resourceAwsShieldProactiveEngagementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	if err := updateShieldProactiveEngagementEnabled(conn, false); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Shield emergency contact settings")
+	_, err := conn.UpdateEmergencyContactSettings(&shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield emergency contact settings: %w", err)
+	}
+
+	return nil
+}
+
+func updateShieldProactiveEngagementEnabled(conn *shield.Shield, enabled bool) error {
+	subscription, err := finder.Subscription(conn)
+
+	if tfresource.NotFound(err) && !enabled {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription: %w", err)
+	}
+
+	// Enabling or disabling proactive engagement when it is already in that state is an error.
+	status := aws.StringValue(subscription.ProactiveEngagementStatus)
+
+	if enabled && status == shield.ProactiveEngagementStatusEnabled {
+		return nil
+	}
+
+	if !enabled && (status == "" || status == shield.ProactiveEngagementStatusDisabled) {
+		return nil
+	}
+
+	if enabled {
+		_, err = conn.EnableProactiveEngagement(&shield.EnableProactiveEngagementInput{})
+	} else {
+		_, err = conn.DisableProactiveEngagement(&shield.DisableProactiveEngagementInput{})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating Shield Proactive Engagement (enabled: %t): %w", enabled, err)
+	}
+
+	return nil
+}
+
+func expandShieldEmergencyContacts(tfList []interface{}) []*shield.EmergencyContact {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*shield.EmergencyContact
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &shield.EmergencyContact{}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			apiObject.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["email_address"].(string); ok && v != "" {
+			apiObject.EmailAddress = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			apiObject.PhoneNumber = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenShieldEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ContactNotes; v != nil {
+			tfMap["contact_notes"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.EmailAddress; v != nil {
+			tfMap["email_address"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.PhoneNumber; v != nil {
+			tfMap["phone_number"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
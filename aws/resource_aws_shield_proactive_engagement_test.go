@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/shield/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// Proactive engagement is configured once per account, so these tests must not run in parallel.
+func TestAccAWSShieldProactiveEngagement_basic(t *testing.T) {
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, shield.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProactiveEngagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProactiveEngagementConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "security@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12345678901"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Security team"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldProactiveEngagementConfigUpdated(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", "operations@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldProactiveEngagementDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_proactive_engagement" {
+			continue
+		}
+
+		subscription, err := finder.Subscription(conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+			return fmt.Errorf("Shield Proactive Engagement %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldProactiveEngagementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Proactive Engagement ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		subscription, err := finder.Subscription(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if subscription.ProactiveEngagementStatus == nil {
+			return fmt.Errorf("Shield Proactive Engagement %s not initialized", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccShieldProactiveEngagementConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Security team"
+    email_address = "security@example.com"
+    phone_number  = "+12345678901"
+  }
+}
+`, enabled)
+}
+
+func testAccShieldProactiveEngagementConfigUpdated(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Security team"
+    email_address = "security@example.com"
+    phone_number  = "+12345678901"
+  }
+
+  emergency_contact {
+    email_address = "operations@example.com"
+    phone_number  = "+12345678902"
+  }
+}
+`, enabled)
+}
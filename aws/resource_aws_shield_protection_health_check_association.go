@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfshield "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/shield"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/shield/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsShieldProtectionHealthCheckAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldProtectionHealthCheckAssociationCreate,
+		Read:   resourceAwsShieldProtectionHealthCheckAssociationRead,
+		Delete: resourceAwsShieldProtectionHealthCheckAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"health_check_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"shield_protection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldProtectionHealthCheckAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionID := d.Get("shield_protection_id").(string)
+	healthCheckARN := d.Get("health_check_arn").(string)
+	id := tfshield.ProtectionHealthCheckAssociationCreateID(protectionID, healthCheckARN)
+
+	input := &shield.AssociateHealthCheckInput{
+		HealthCheckArn: aws.String(healthCheckARN),
+		ProtectionId:   aws.String(protectionID),
+	}
+
+	log.Printf("[DEBUG] Creating Shield Protection Health Check Association: %s", input)
+	_, err := conn.AssociateHealthCheck(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Shield Protection Health Check Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsShieldProtectionHealthCheckAssociationRead(d, meta)
+}
+
+func resourceAwsShieldProtectionHealthCheckAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionID, healthCheckARN, err := tfshield.ProtectionHealthCheckAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	healthCheckID, err := shieldHealthCheckIDFromARN(healthCheckARN)
+
+	if err != nil {
+		return err
+	}
+
+	protection, err := finder.ProtectionByID(conn, protectionID)
+
+	if err == nil {
+		err = &resource.NotFoundError{}
+
+		for _, v := range protection.HealthCheckIds {
+			if aws.StringValue(v) == healthCheckID {
+				err = nil
+				break
+			}
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Protection Health Check Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("health_check_arn", healthCheckARN)
+	d.Set("shield_protection_id", protectionID)
+
+	return nil
+}
+
+func resourceAwsShieldProtectionHealthCheckAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	protectionID, healthCheckARN, err := tfshield.ProtectionHealthCheckAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Shield Protection Health Check Association: %s", d.Id())
+	_, err = conn.DisassociateHealthCheck(&shield.DisassociateHealthCheckInput{
+		HealthCheckArn: aws.String(healthCheckARN),
+		ProtectionId:   aws.String(protectionID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield Protection Health Check Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// shieldHealthCheckIDFromARN returns the Route 53 health check ID from its ARN,
+// e.g. arn:aws:route53:::healthcheck/abcdef12-3456-7890-abcd-ef1234567890.
+func shieldHealthCheckIDFromARN(v string) (string, error) {
+	healthCheckARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing health check ARN (%s): %w", v, err)
+	}
+
+	id := strings.TrimPrefix(healthCheckARN.Resource, "healthcheck/")
+
+	if id == "" || id == healthCheckARN.Resource {
+		return "", fmt.Errorf("unexpected format for health check ARN (%s)", v)
+	}
+
+	return id, nil
+}
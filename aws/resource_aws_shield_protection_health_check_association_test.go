@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfshield "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/shield"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/shield/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSShieldProtectionHealthCheckAssociation_basic(t *testing.T) {
+	resourceName := "aws_shield_protection_health_check_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, shield.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionHealthCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionHealthCheckAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionHealthCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "health_check_arn", "aws_route53_health_check.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "shield_protection_id", "aws_shield_protection.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtectionHealthCheckAssociation_disappears(t *testing.T) {
+	resourceName := "aws_shield_protection_health_check_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(shield.EndpointsID, t)
+			testAccPreCheckAWSShield(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, shield.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionHealthCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionHealthCheckAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionHealthCheckAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsShieldProtectionHealthCheckAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldProtectionHealthCheckAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection_health_check_association" {
+			continue
+		}
+
+		protectionID, healthCheckARN, err := tfshield.ProtectionHealthCheckAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		protection, err := finder.ProtectionByID(conn, protectionID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		healthCheckID, err := shieldHealthCheckIDFromARN(healthCheckARN)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range protection.HealthCheckIds {
+			if aws.StringValue(v) == healthCheckID {
+				return fmt.Errorf("Shield Protection Health Check Association %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldProtectionHealthCheckAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Protection Health Check Association ID is set")
+		}
+
+		protectionID, healthCheckARN, err := tfshield.ProtectionHealthCheckAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		healthCheckID, err := shieldHealthCheckIDFromARN(healthCheckARN)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		protection, err := finder.ProtectionByID(conn, protectionID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range protection.HealthCheckIds {
+			if aws.StringValue(v) == healthCheckID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Shield Protection Health Check Association %s not found", rs.Primary.ID)
+	}
+}
+
+func testAccShieldProtectionHealthCheckAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+}
+
+resource "aws_route53_health_check" "test" {
+  ip_address        = aws_eip.test.public_ip
+  port              = 80
+  type              = "HTTP"
+  failure_threshold = "2"
+  request_interval  = "30"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection_health_check_association" "test" {
+  health_check_arn     = aws_route53_health_check.test.arn
+  shield_protection_id = aws_shield_protection.test.id
+}
+`, rName)
+}
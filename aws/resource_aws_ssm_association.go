@@ -39,6 +39,11 @@ func resourceAwsSsmAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"instance_id": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -88,6 +93,12 @@ func resourceAwsSsmAssociation() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"s3_region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(3, 20),
+						},
 					},
 				},
 			},
@@ -186,6 +197,10 @@ func resourceAwsSsmAssociationCreate(d *schema.ResourceData, meta interface{}) e
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = expandStringSet(v.(*schema.Set))
+	}
+
 	resp, err := ssmconn.CreateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error creating SSM association: %s", err)
@@ -236,6 +251,10 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("max_errors", association.MaxErrors)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
 
+	if err := d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames)); err != nil {
+		return fmt.Errorf("error setting calendar_names: %w", err)
+	}
+
 	if err := d.Set("parameters", flattenAwsSsmParameters(association.Parameters)); err != nil {
 		return err
 	}
@@ -305,6 +324,9 @@ func resourceAwsSsmAssociationUpdate(d *schema.ResourceData, meta interface{}) e
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	// An empty list removes the association from all calendars.
+	associationInput.CalendarNames = expandStringSet(d.Get("calendar_names").(*schema.Set))
+
 	_, err := ssmconn.UpdateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error updating SSM association: %s", err)
@@ -358,6 +380,10 @@ func expandSSMAssociationOutputLocation(config []interface{}) *ssm.InstanceAssoc
 		S3OutputLocation.OutputS3KeyPrefix = aws.String(v.(string))
 	}
 
+	if v, ok := locationConfig["s3_region"].(string); ok && v != "" {
+		S3OutputLocation.OutputS3Region = aws.String(v)
+	}
+
 	return &ssm.InstanceAssociationOutputLocation{
 		S3Location: S3OutputLocation,
 	}
@@ -377,6 +403,10 @@ func flattenAwsSsmAssociationOutoutLocation(location *ssm.InstanceAssociationOut
 		item["s3_key_prefix"] = *location.S3Location.OutputS3KeyPrefix
 	}
 
+	if location.S3Location.OutputS3Region != nil {
+		item["s3_region"] = aws.StringValue(location.S3Location.OutputS3Region)
+	}
+
 	result = append(result, item)
 
 	return result
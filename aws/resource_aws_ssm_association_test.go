@@ -92,6 +92,49 @@ func TestAccAWSSSMAssociation_ApplyOnlyAtCronInterval(t *testing.T) {
 	})
 }
 
+func TestAccAWSSSMAssociation_CalendarNames(t *testing.T) {
+	name := acctest.RandString(10)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssm.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMAssociationBasicConfigWithCalendarNames(name, "aws_ssm_document.calendar1.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSMAssociationBasicConfigWithCalendarNames(name, "aws_ssm_document.calendar1.arn, aws_ssm_document.calendar2.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar1", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar2", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSSSMAssociationBasicConfigWithCalendarNames(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSSMAssociation_withTargets(t *testing.T) {
 	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
@@ -354,6 +397,23 @@ func TestAccAWSSSMAssociation_withOutputLocation(t *testing.T) {
 						resourceName, "output_location.0.s3_key_prefix", "UpdatedAssociation"),
 				),
 			},
+			{
+				Config: testAccAWSSSMAssociationBasicConfigWithOutPutLocationUpdateS3Region(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(
+						resourceName, "output_location.0.s3_bucket_name", fmt.Sprintf("tf-acc-test-ssmoutput-updated-%s", name)),
+					resource.TestCheckResourceAttr(
+						resourceName, "output_location.0.s3_key_prefix", "UpdatedAssociation"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "output_location.0.s3_region", "aws_s3_bucket.output_location_updated", "region"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -604,6 +664,78 @@ resource "aws_ssm_association" "test" {
 `, rName, applyOnlyAtCronInterval)
 }
 
+func testAccAWSSSMAssociationBasicConfigWithCalendarNames(rName, calendarNames string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = "test_document_association-%[1]s"
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_document" "calendar1" {
+  name            = "test_calendar1_association-%[1]s"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:test
+END:VCALENDAR
+DOC
+
+}
+
+resource "aws_ssm_document" "calendar2" {
+  name            = "test_calendar2_association-%[1]s"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:test
+END:VCALENDAR
+DOC
+
+}
+
+resource "aws_ssm_association" "test" {
+  name           = aws_ssm_document.test.name
+  calendar_names = [%[2]s]
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, calendarNames)
+}
+
 func testAccAWSSSMAssociationBasicConfigWithAutomationTargetParamName(rName string) string {
 	return composeConfig(testAccLatestAmazonLinuxHvmEbsAmiConfig(), fmt.Sprintf(`
 resource "aws_iam_instance_profile" "ssm_profile" {
@@ -1225,6 +1357,61 @@ resource "aws_ssm_association" "test" {
 `, rName, rName, rName)
 }
 
+func testAccAWSSSMAssociationBasicConfigWithOutPutLocationUpdateS3Region(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "output_location" {
+  bucket        = "tf-acc-test-ssmoutput-%s"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket" "output_location_updated" {
+  bucket        = "tf-acc-test-ssmoutput-updated-%s"
+  force_destroy = true
+}
+
+resource "aws_ssm_document" "test" {
+  name          = "test_document_association-%s"
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_association" "test" {
+  name = aws_ssm_document.test.name
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+
+  output_location {
+    s3_bucket_name = aws_s3_bucket.output_location_updated.id
+    s3_key_prefix  = "UpdatedAssociation"
+    s3_region      = aws_s3_bucket.output_location_updated.region
+  }
+}
+`, rName, rName, rName)
+}
+
 func testAccAWSSSMAssociationBasicConfigWithAssociationName(rName, assocName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
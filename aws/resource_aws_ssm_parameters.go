@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssm/finder"
+)
+
+const (
+	// DeleteParameters accepts at most 10 names per request.
+	ssmParametersDeleteBatchSize = 10
+)
+
+func resourceAwsSsmParameters() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsmParametersPut,
+		Read:   resourceAwsSsmParametersRead,
+		Update: resourceAwsSsmParametersPut,
+		Delete: resourceAwsSsmParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmParametersImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1011),
+								validation.StringMatch(regexp.MustCompile(`^[^/]`), "must be relative to path and not begin with /"),
+							),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.ParameterTypeString,
+							ValidateFunc: validation.StringInSlice(ssm.ParameterType_Values(), false),
+						},
+						"value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 2048),
+					validation.StringMatch(regexp.MustCompile(`^/`), "must begin with /"),
+					validation.StringNotInSlice([]string{"/"}, false),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsSsmParametersPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	path := d.Get("path").(string)
+	prefix := ssmParametersPrefix(path)
+
+	o, n := d.GetChange("parameter")
+	oldParameters := expandSsmParametersMap(o.(*schema.Set))
+	newParameters := expandSsmParametersMap(n.(*schema.Set))
+
+	var remove []string
+	for name := range oldParameters {
+		if _, ok := newParameters[name]; !ok {
+			remove = append(remove, prefix+name)
+		}
+	}
+
+	if err := deleteSsmParameters(conn, remove); err != nil {
+		return fmt.Errorf("error deleting SSM Parameters (%s): %w", path, err)
+	}
+
+	// PutParameter has no batch form; only new or changed parameters are written.
+	for name, tfMap := range newParameters {
+		if v, ok := oldParameters[name]; ok && v["type"] == tfMap["type"] && v["value"] == tfMap["value"] {
+			continue
+		}
+
+		input := &ssm.PutParameterInput{
+			Name:      aws.String(prefix + name),
+			Overwrite: aws.Bool(true),
+			Type:      aws.String(tfMap["type"].(string)),
+			Value:     aws.String(tfMap["value"].(string)),
+		}
+
+		// The type of an existing parameter cannot be changed with Overwrite.
+		if v, ok := oldParameters[name]; ok && v["type"] != tfMap["type"] {
+			if err := deleteSsmParameters(conn, []string{prefix + name}); err != nil {
+				return fmt.Errorf("error deleting SSM Parameter (%s): %w", prefix+name, err)
+			}
+		}
+
+		log.Printf("[DEBUG] Putting SSM Parameter: %s", prefix+name)
+		if _, err := conn.PutParameter(input); err != nil {
+			return fmt.Errorf("error putting SSM Parameter (%s): %w", prefix+name, err)
+		}
+	}
+
+	d.SetId(path)
+
+	return resourceAwsSsmParametersRead(d, meta)
+}
+
+func resourceAwsSsmParametersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	output, err := finder.ParametersByPath(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Parameters (%s): %w", d.Id(), err)
+	}
+
+	prefix := ssmParametersPrefix(d.Id())
+	managed := expandSsmParametersMap(d.Get("parameter").(*schema.Set))
+
+	// Only parameters already managed by this resource are tracked, so
+	// parameters under the path owned by something else are left alone.
+	var parameters []*ssm.Parameter
+	for _, parameter := range output {
+		if _, ok := managed[strings.TrimPrefix(aws.StringValue(parameter.Name), prefix)]; ok {
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	if !d.IsNewResource() && len(parameters) == 0 && len(managed) > 0 {
+		log.Printf("[WARN] SSM Parameters (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  strings.TrimPrefix(aws.StringValue(parameter.Name), prefix),
+			"type":  aws.StringValue(parameter.Type),
+			"value": aws.StringValue(parameter.Value),
+		})
+	}
+
+	if err := d.Set("parameter", tfList); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+	d.Set("path", d.Id())
+
+	return nil
+}
+
+func resourceAwsSsmParametersImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ssmconn
+
+	parameters, err := finder.ParametersByPath(conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading SSM Parameters (%s): %w", d.Id(), err)
+	}
+
+	// Importing takes over every parameter currently under the path.
+	prefix := ssmParametersPrefix(d.Id())
+	tfList := make([]interface{}, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  strings.TrimPrefix(aws.StringValue(parameter.Name), prefix),
+			"type":  aws.StringValue(parameter.Type),
+			"value": aws.StringValue(parameter.Value),
+		})
+	}
+
+	if err := d.Set("parameter", tfList); err != nil {
+		return nil, fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsSsmParametersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	prefix := ssmParametersPrefix(d.Id())
+	var names []string
+
+	for name := range expandSsmParametersMap(d.Get("parameter").(*schema.Set)) {
+		names = append(names, prefix+name)
+	}
+
+	log.Printf("[INFO] Deleting SSM Parameters: %s", d.Id())
+	if err := deleteSsmParameters(conn, names); err != nil {
+		return fmt.Errorf("error deleting SSM Parameters (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// deleteSsmParameters deletes the named parameters in batches.
+// Names that do not exist are ignored.
+func deleteSsmParameters(conn *ssm.SSM, names []string) error {
+	for i := 0; i < len(names); i += ssmParametersDeleteBatchSize {
+		j := i + ssmParametersDeleteBatchSize
+		if j > len(names) {
+			j = len(names)
+		}
+
+		_, err := conn.DeleteParameters(&ssm.DeleteParametersInput{
+			Names: aws.StringSlice(names[i:j]),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ssmParametersPrefix returns the prefix prepended to relative parameter names under path.
+func ssmParametersPrefix(path string) string {
+	return strings.TrimSuffix(path, "/") + "/"
+}
+
+func expandSsmParametersMap(tfSet *schema.Set) map[string]map[string]interface{} {
+	apiObject := make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject[tfMap["name"].(string)] = tfMap
+	}
+
+	return apiObject
+}
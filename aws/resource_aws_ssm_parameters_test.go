@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssm/finder"
+)
+
+func TestAccAWSSSMParameters_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssm.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParametersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParametersExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "one",
+						"type":  ssm.ParameterTypeString,
+						"value": "value1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "nested/two",
+						"type":  ssm.ParameterTypeSecureString,
+						"value": "value2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMParameters_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssm.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParametersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParametersExists(resourceName, 2),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsmParameters(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMParameters_update(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssm.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParametersConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParametersExists(resourceName, 2),
+				),
+			},
+			{
+				Config: testAccAWSSSMParametersConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParametersExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "one",
+						"type":  ssm.ParameterTypeStringList,
+						"value": "value1,value1updated",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "three",
+						"type":  ssm.ParameterTypeString,
+						"value": "value3",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSMParameters_unmanagedSibling(t *testing.T) {
+	var param ssm.Parameter
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ssm_parameters.test"
+	siblingResourceName := "aws_ssm_parameter.sibling"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssm.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSMParametersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSMParametersConfigUnmanagedSibling(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSMParametersExists(resourceName, 3),
+					testAccCheckAWSSSMParameterExists(siblingResourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSSMParametersExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Parameters path is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+		output, err := finder.ParametersByPath(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) != count {
+			return fmt.Errorf("SSM Parameters (%s) count: expected %d, got %d", rs.Primary.ID, count, len(output))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSSMParametersDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_parameters" {
+			continue
+		}
+
+		output, err := finder.ParametersByPath(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			continue
+		}
+
+		return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSSSMParametersConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "one"
+    value = "value1"
+  }
+
+  parameter {
+    name  = "nested/two"
+    type  = "SecureString"
+    value = "value2"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSSMParametersConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "one"
+    type  = "StringList"
+    value = "value1,value1updated"
+  }
+
+  parameter {
+    name  = "three"
+    value = "value3"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSSMParametersConfigUnmanagedSibling(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "sibling" {
+  name  = "/%[1]s/sibling"
+  type  = "String"
+  value = "sibling"
+}
+
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "one"
+    value = "value1"
+  }
+
+  parameter {
+    name  = "nested/two"
+    type  = "SecureString"
+    value = "value2"
+  }
+
+  # Create the parameters only after the unmanaged sibling exists.
+  depends_on = [aws_ssm_parameter.sibling]
+}
+`, rName)
+}
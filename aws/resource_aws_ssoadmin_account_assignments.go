@@ -0,0 +1,349 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/waiter"
+)
+
+func resourceAwsSsoAdminAccountAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminAccountAssignmentsPut,
+		Read:   resourceAwsSsoAdminAccountAssignmentsRead,
+		Update: resourceAwsSsoAdminAccountAssignmentsPut,
+		Delete: resourceAwsSsoAdminAccountAssignmentsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assignment": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 47),
+								validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+							),
+						},
+						"principal_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+						},
+						"target_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+					},
+				},
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+// ssoAdminAccountAssignmentKey uniquely identifies an account assignment of a permission set.
+type ssoAdminAccountAssignmentKey struct {
+	principalID   string
+	principalType string
+	targetID      string
+}
+
+// ssoAdminAccountAssignmentRequest is an in-flight account assignment creation or deletion.
+type ssoAdminAccountAssignmentRequest struct {
+	key       ssoAdminAccountAssignmentKey
+	requestID string
+	deletion  bool
+}
+
+func resourceAwsSsoAdminAccountAssignmentsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s,%s", permissionSetArn, instanceArn))
+	}
+
+	// The assignments are authoritative for the permission set, so reconcile
+	// against the assignments that currently exist rather than prior state.
+	accountAssignments, err := finder.AccountAssignmentsByPermissionSet(conn, permissionSetArn, instanceArn)
+
+	if err != nil {
+		return fmt.Errorf("error listing SSO Account Assignments for PermissionSet (%s): %w", permissionSetArn, err)
+	}
+
+	current := make(map[ssoAdminAccountAssignmentKey]struct{})
+	for _, a := range accountAssignments {
+		current[ssoAdminAccountAssignmentKey{
+			principalID:   aws.StringValue(a.PrincipalId),
+			principalType: aws.StringValue(a.PrincipalType),
+			targetID:      aws.StringValue(a.AccountId),
+		}] = struct{}{}
+	}
+
+	desired := expandSsoAdminAccountAssignmentKeys(d.Get("assignment").(*schema.Set))
+
+	var requests []ssoAdminAccountAssignmentRequest
+
+	for key := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+
+		request, err := deleteSsoAdminAccountAssignment(conn, key, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if request != nil {
+			requests = append(requests, *request)
+		}
+	}
+
+	for key := range desired {
+		if _, ok := current[key]; ok {
+			continue
+		}
+
+		input := &ssoadmin.CreateAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+			PrincipalId:      aws.String(key.principalID),
+			PrincipalType:    aws.String(key.principalType),
+			TargetId:         aws.String(key.targetID),
+			TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+		}
+
+		output, err := conn.CreateAccountAssignment(input)
+
+		if err != nil {
+			return fmt.Errorf("error creating SSO Account Assignment for %s (%s) in AccountId (%s): %w", key.principalType, key.principalID, key.targetID, err)
+		}
+
+		if output == nil || output.AccountAssignmentCreationStatus == nil {
+			return fmt.Errorf("error creating SSO Account Assignment for %s (%s) in AccountId (%s): empty output", key.principalType, key.principalID, key.targetID)
+		}
+
+		requests = append(requests, ssoAdminAccountAssignmentRequest{
+			key:       key,
+			requestID: aws.StringValue(output.AccountAssignmentCreationStatus.RequestId),
+		})
+	}
+
+	if err := waitSsoAdminAccountAssignmentRequests(conn, instanceArn, requests); err != nil {
+		return err
+	}
+
+	return resourceAwsSsoAdminAccountAssignmentsRead(d, meta)
+}
+
+func resourceAwsSsoAdminAccountAssignmentsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := parseSsoAdminResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Account Assignments ID: %w", err)
+	}
+
+	accountAssignments, err := finder.AccountAssignmentsByPermissionSet(conn, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing SSO Account Assignments from state", permissionSetArn)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Account Assignments for PermissionSet (%s): %w", permissionSetArn, err)
+	}
+
+	if err := d.Set("assignment", flattenSsoAdminAccountAssignments(accountAssignments)); err != nil {
+		return fmt.Errorf("error setting assignment: %w", err)
+	}
+	d.Set("instance_arn", instanceArn)
+	d.Set("permission_set_arn", permissionSetArn)
+
+	return nil
+}
+
+func resourceAwsSsoAdminAccountAssignmentsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := parseSsoAdminResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Account Assignments ID: %w", err)
+	}
+
+	var requests []ssoAdminAccountAssignmentRequest
+
+	for key := range expandSsoAdminAccountAssignmentKeys(d.Get("assignment").(*schema.Set)) {
+		request, err := deleteSsoAdminAccountAssignment(conn, key, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if request != nil {
+			requests = append(requests, *request)
+		}
+	}
+
+	return waitSsoAdminAccountAssignmentRequests(conn, instanceArn, requests)
+}
+
+// deleteSsoAdminAccountAssignment starts the deletion of an account assignment without waiting for it to complete.
+// Returns nil if the account assignment no longer exists.
+func deleteSsoAdminAccountAssignment(conn *ssoadmin.SSOAdmin, key ssoAdminAccountAssignmentKey, permissionSetArn, instanceArn string) (*ssoAdminAccountAssignmentRequest, error) {
+	input := &ssoadmin.DeleteAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+		PrincipalId:      aws.String(key.principalID),
+		PrincipalType:    aws.String(key.principalType),
+		TargetId:         aws.String(key.targetID),
+		TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+	}
+
+	output, err := conn.DeleteAccountAssignment(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error deleting SSO Account Assignment for %s (%s) in AccountId (%s): %w", key.principalType, key.principalID, key.targetID, err)
+	}
+
+	if output == nil || output.AccountAssignmentDeletionStatus == nil {
+		return nil, fmt.Errorf("error deleting SSO Account Assignment for %s (%s) in AccountId (%s): empty output", key.principalType, key.principalID, key.targetID)
+	}
+
+	return &ssoAdminAccountAssignmentRequest{
+		key:       key,
+		requestID: aws.StringValue(output.AccountAssignmentDeletionStatus.RequestId),
+		deletion:  true,
+	}, nil
+}
+
+// waitSsoAdminAccountAssignmentRequests waits for all of the account assignment requests to complete,
+// polling up to waiter.AWSSSOAdminAccountAssignmentWaiterConcurrency requests at a time.
+func waitSsoAdminAccountAssignmentRequests(conn *ssoadmin.SSOAdmin, instanceArn string, requests []ssoAdminAccountAssignmentRequest) error {
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, waiter.AWSSSOAdminAccountAssignmentWaiterConcurrency)
+
+	for _, request := range requests {
+		request := request
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var err error
+
+			if request.deletion {
+				_, err = waiter.AccountAssignmentDeleted(conn, instanceArn, request.requestID)
+
+				if err != nil {
+					err = fmt.Errorf("error waiting for SSO Account Assignment for %s (%s) in AccountId (%s) to be deleted: %w", request.key.principalType, request.key.principalID, request.key.targetID, err)
+				}
+			} else {
+				_, err = waiter.AccountAssignmentCreated(conn, instanceArn, request.requestID)
+
+				if err != nil {
+					err = fmt.Errorf("error waiting for SSO Account Assignment for %s (%s) in AccountId (%s) to be created: %w", request.key.principalType, request.key.principalID, request.key.targetID, err)
+				}
+			}
+
+			if err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func expandSsoAdminAccountAssignmentKeys(tfSet *schema.Set) map[ssoAdminAccountAssignmentKey]struct{} {
+	keys := make(map[ssoAdminAccountAssignmentKey]struct{})
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		keys[ssoAdminAccountAssignmentKey{
+			principalID:   tfMap["principal_id"].(string),
+			principalType: tfMap["principal_type"].(string),
+			targetID:      tfMap["target_id"].(string),
+		}] = struct{}{}
+	}
+
+	return keys
+}
+
+func flattenSsoAdminAccountAssignments(apiObjects []*ssoadmin.AccountAssignment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"principal_id":   aws.StringValue(apiObject.PrincipalId),
+			"principal_type": aws.StringValue(apiObject.PrincipalType),
+			"target_id":      aws.StringValue(apiObject.AccountId),
+		})
+	}
+
+	return tfList
+}
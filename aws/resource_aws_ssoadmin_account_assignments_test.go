@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+)
+
+func TestAccAWSSSOAdminAccountAssignments_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminAccountAssignmentsConfig(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentsCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "assignment.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "assignment.*.principal_id", "data.aws_identitystore_group.test", "group_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "assignment.*.target_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", "aws_ssoadmin_permission_set.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSOAdminAccountAssignmentsConfigEmpty(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentsCount(resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSOAdminAccountAssignments_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		ErrorCheck:   testAccErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminAccountAssignmentsConfig(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentsCount(resourceName, 1),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsoAdminAccountAssignments(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_account_assignments" {
+			continue
+		}
+
+		permissionSetArn, instanceArn, err := parseSsoAdminResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Account Assignments ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		accountAssignments, err := finder.AccountAssignmentsByPermissionSet(conn, permissionSetArn, instanceArn)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SSO Account Assignments for PermissionSet (%s): %w", permissionSetArn, err)
+		}
+
+		if len(accountAssignments) > 0 {
+			return fmt.Errorf("SSO Account Assignments for PermissionSet (%s) still exist", permissionSetArn)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentsCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+		permissionSetArn, instanceArn, err := parseSsoAdminResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Account Assignments ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		accountAssignments, err := finder.AccountAssignmentsByPermissionSet(conn, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(accountAssignments); got != expected {
+			return fmt.Errorf("SSO Account Assignments for PermissionSet (%s): got %d, expected %d", permissionSetArn, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSSOAdminAccountAssignmentsConfigBase(groupName, rName string) string {
+	return composeConfig(
+		testAccAWSSSOAdminAccountAssignmentBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %q
+  }
+}
+`, groupName))
+}
+
+func testAccAWSSSOAdminAccountAssignmentsConfig(groupName, rName string) string {
+	return composeConfig(
+		testAccAWSSSOAdminAccountAssignmentsConfigBase(groupName, rName),
+		`
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  assignment {
+    principal_id   = data.aws_identitystore_group.test.group_id
+    principal_type = "GROUP"
+    target_id      = data.aws_caller_identity.current.account_id
+  }
+}
+`)
+}
+
+func testAccAWSSSOAdminAccountAssignmentsConfigEmpty(groupName, rName string) string {
+	return composeConfig(
+		testAccAWSSSOAdminAccountAssignmentsConfigBase(groupName, rName),
+		`
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+`)
+}
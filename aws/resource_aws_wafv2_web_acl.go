@@ -1,13 +1,16 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -92,8 +95,9 @@ func resourceAwsWafv2WebACL() *schema.Resource {
 				}, false),
 			},
 			"rule": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"rules_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
@@ -133,6 +137,16 @@ func resourceAwsWafv2WebACL() *schema.Resource {
 					},
 				},
 			},
+			"rules_json": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rule"},
+				ValidateFunc:  validateWafv2WebACLRulesJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := wafv2WebACLRulesJSONAreEquivalent(old, new)
+					return equal
+				},
+			},
 			"tags":              tagsSchema(),
 			"tags_all":          tagsSchemaComputed(),
 			"visibility_config": wafv2VisibilityConfigSchema(),
@@ -160,6 +174,16 @@ func resourceAwsWafv2WebACLCreate(d *schema.ResourceData, meta interface{}) erro
 		params.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("rules_json"); ok {
+		rules, err := expandWafv2WebACLRulesJSON(v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		params.Rules = rules
+	}
+
 	if len(tags) > 0 {
 		params.Tags = tags.IgnoreAws().Wafv2Tags()
 	}
@@ -228,8 +252,20 @@ func resourceAwsWafv2WebACLRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting default_action: %w", err)
 	}
 
-	if err := d.Set("rule", flattenWafv2WebACLRules(resp.WebACL.Rules)); err != nil {
-		return fmt.Errorf("Error setting rule: %w", err)
+	// Rules are written to whichever of rule or rules_json is in use.
+	if _, ok := d.GetOk("rules_json"); ok {
+		rulesJSON, err := flattenWafv2WebACLRulesJSON(resp.WebACL.Rules)
+
+		if err != nil {
+			return fmt.Errorf("Error flattening rules_json: %w", err)
+		}
+
+		d.Set("rules_json", rulesJSON)
+		d.Set("rule", nil)
+	} else {
+		if err := d.Set("rule", flattenWafv2WebACLRules(resp.WebACL.Rules)); err != nil {
+			return fmt.Errorf("Error setting rule: %w", err)
+		}
 	}
 
 	if err := d.Set("visibility_config", flattenWafv2VisibilityConfig(resp.WebACL.VisibilityConfig)); err != nil {
@@ -259,7 +295,7 @@ func resourceAwsWafv2WebACLRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsWafv2WebACLUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).wafv2conn
 
-	if d.HasChanges("default_action", "description", "rule", "visibility_config") {
+	if d.HasChanges("default_action", "description", "rule", "rules_json", "visibility_config") {
 		u := &wafv2.UpdateWebACLInput{
 			Id:               aws.String(d.Id()),
 			Name:             aws.String(d.Get("name").(string)),
@@ -274,6 +310,16 @@ func resourceAwsWafv2WebACLUpdate(d *schema.ResourceData, meta interface{}) erro
 			u.Description = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("rules_json"); ok {
+			rules, err := expandWafv2WebACLRulesJSON(v.(string))
+
+			if err != nil {
+				return err
+			}
+
+			u.Rules = rules
+		}
+
 		err := resource.Retry(Wafv2WebACLUpdateTimeout, func() *resource.RetryError {
 			_, err := conn.UpdateWebACL(u)
 			if err != nil {
@@ -789,6 +835,76 @@ func flattenWafv2WebACLRules(r []*wafv2.Rule) interface{} {
 	return out
 }
 
+// expandWafv2WebACLRulesJSON decodes a JSON array of rules as accepted by the WAFv2 API.
+// Requests are serialized from SDK types, so statements and fields unknown to the
+// SDK cannot be sent; they are rejected here rather than silently dropped.
+func expandWafv2WebACLRulesJSON(rawRules string) ([]*wafv2.Rule, error) {
+	var rules []*wafv2.Rule
+
+	decoder := json.NewDecoder(strings.NewReader(rawRules))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("Error decoding rules_json: %w", err)
+	}
+
+	return rules, nil
+}
+
+// flattenWafv2WebACLRulesJSON encodes rules in priority order.
+func flattenWafv2WebACLRulesJSON(rules []*wafv2.Rule) (string, error) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return aws.Int64Value(rules[i].Priority) < aws.Int64Value(rules[j].Priority)
+	})
+
+	b, err := jsonutil.BuildJSON(rules)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// wafv2WebACLRulesJSONAreEquivalent determines equality between two rules JSON strings,
+// ignoring formatting, key order and rule order.
+func wafv2WebACLRulesJSONAreEquivalent(rules1, rules2 string) (bool, error) {
+	obj1, err := expandWafv2WebACLRulesJSON(rules1)
+	if err != nil {
+		return false, err
+	}
+
+	canonicalJson1, err := flattenWafv2WebACLRulesJSON(obj1)
+	if err != nil {
+		return false, err
+	}
+
+	obj2, err := expandWafv2WebACLRulesJSON(rules2)
+	if err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := flattenWafv2WebACLRulesJSON(obj2)
+	if err != nil {
+		return false, err
+	}
+
+	equal := canonicalJson1 == canonicalJson2
+	if !equal {
+		log.Printf("[DEBUG] Canonical WAFv2 rules are not equal.\nFirst: %s\nSecond: %s\n", canonicalJson1, canonicalJson2)
+	}
+
+	return equal, nil
+}
+
+func validateWafv2WebACLRulesJSON(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandWafv2WebACLRulesJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON array of rules: %w", k, err))
+	}
+
+	return
+}
+
 func flattenWafv2OverrideAction(a *wafv2.OverrideAction) interface{} {
 	if a == nil {
 		return []interface{}{}
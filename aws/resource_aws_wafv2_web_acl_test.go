@@ -1459,6 +1459,98 @@ func TestAccAwsWafv2WebACL_Operators_maxNested(t *testing.T) {
 	})
 }
 
+func TestAccAwsWafv2WebACL_rulesJSON(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSWafv2ScopeRegional(t) },
+		ErrorCheck:   testAccErrorCheck(t, wafv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWafv2WebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWafv2WebACLConfig_RulesJSON(webACLName, 50000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2WebACLExists(resourceName, &v),
+					testAccCheckAwsWafv2WebACLRateBasedLimit(&v, "rule-1", 50000),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules_json"),
+				),
+			},
+			{
+				Config:   testAccAwsWafv2WebACLConfig_RulesJSONReordered(webACLName, 50000),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAwsWafv2WebACLConfig_RulesJSON(webACLName, 10000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWafv2WebACLExists(resourceName, &v),
+					testAccCheckAwsWafv2WebACLRateBasedLimit(&v, "rule-1", 10000),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rule", "rules_json"},
+				ImportStateIdFunc:       testAccAwsWafv2WebACLImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestWafv2WebACLRulesJSONAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Rules1   string
+		Rules2   string
+		Expected bool
+	}{
+		{
+			Name:     "identical",
+			Rules1:   `[{"Name":"rule-1","Priority":1,"Action":{"Count":{}},"Statement":{"RateBasedStatement":{"Limit":50000,"AggregateKeyType":"IP"}}}]`,
+			Rules2:   `[{"Name":"rule-1","Priority":1,"Action":{"Count":{}},"Statement":{"RateBasedStatement":{"Limit":50000,"AggregateKeyType":"IP"}}}]`,
+			Expected: true,
+		},
+		{
+			Name:     "key order and whitespace",
+			Rules1:   `[{"Name":"rule-1","Priority":1,"Action":{"Count":{}}}]`,
+			Rules2:   `[ { "Action": { "Count": {} }, "Priority": 1, "Name": "rule-1" } ]`,
+			Expected: true,
+		},
+		{
+			Name:     "rule order",
+			Rules1:   `[{"Name":"rule-1","Priority":1},{"Name":"rule-2","Priority":2}]`,
+			Rules2:   `[{"Name":"rule-2","Priority":2},{"Name":"rule-1","Priority":1}]`,
+			Expected: true,
+		},
+		{
+			Name:     "different value",
+			Rules1:   `[{"Name":"rule-1","Priority":1,"Statement":{"RateBasedStatement":{"Limit":50000}}}]`,
+			Rules2:   `[{"Name":"rule-1","Priority":1,"Statement":{"RateBasedStatement":{"Limit":10000}}}]`,
+			Expected: false,
+		},
+		{
+			Name:     "unknown field",
+			Rules1:   `[{"Name":"rule-1","Priority":1}]`,
+			Rules2:   `[{"Name":"rule-1","Priority":1,"Unknown":true}]`,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, _ := wafv2WebACLRulesJSONAreEquivalent(testCase.Rules1, testCase.Rules2)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func testAccCheckAwsWafv2WebACLDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafv2_web_acl" {
@@ -2731,6 +2823,133 @@ resource "aws_wafv2_web_acl" "test" {
 `, name)
 }
 
+func testAccCheckAwsWafv2WebACLRateBasedLimit(v *wafv2.WebACL, ruleName string, limit int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rule := range v.Rules {
+			if aws.StringValue(rule.Name) != ruleName {
+				continue
+			}
+
+			if rule.Statement == nil || rule.Statement.RateBasedStatement == nil {
+				return fmt.Errorf("WAFv2 WebACL rule (%s) has no rate-based statement", ruleName)
+			}
+
+			if got := aws.Int64Value(rule.Statement.RateBasedStatement.Limit); got != int64(limit) {
+				return fmt.Errorf("WAFv2 WebACL rule (%s) limit: expected %d, got %d", ruleName, limit, got)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("WAFv2 WebACL rule (%s) not found", ruleName)
+	}
+}
+
+func testAccAwsWafv2WebACLConfig_RulesJSON(name string, limit int) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rules_json = jsonencode([
+    {
+      Name     = "rule-1"
+      Priority = 1
+      Action = {
+        Count = {}
+      }
+      Statement = {
+        RateBasedStatement = {
+          AggregateKeyType = "IP"
+          Limit            = %[2]d
+        }
+      }
+      VisibilityConfig = {
+        CloudWatchMetricsEnabled = false
+        MetricName               = "friendly-rule-metric-name-1"
+        SampledRequestsEnabled   = false
+      }
+    },
+    {
+      Name     = "rule-2"
+      Priority = 2
+      Action = {
+        Block = {}
+      }
+      Statement = {
+        GeoMatchStatement = {
+          CountryCodes = ["US", "NL"]
+        }
+      }
+      VisibilityConfig = {
+        CloudWatchMetricsEnabled = false
+        MetricName               = "friendly-rule-metric-name-2"
+        SampledRequestsEnabled   = false
+      }
+    },
+  ])
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, limit)
+}
+
+func testAccAwsWafv2WebACLConfig_RulesJSONReordered(name string, limit int) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rules_json = <<EOF
+[
+  {
+    "Priority": 2,
+    "Name": "rule-2",
+    "Statement": {"GeoMatchStatement": {"CountryCodes": ["US", "NL"]}},
+    "Action": {"Block": {}},
+    "VisibilityConfig": {
+      "SampledRequestsEnabled": false,
+      "MetricName": "friendly-rule-metric-name-2",
+      "CloudWatchMetricsEnabled": false
+    }
+  },
+  {
+    "Priority": 1,
+    "Name": "rule-1",
+    "Statement": {"RateBasedStatement": {"Limit": %[2]d, "AggregateKeyType": "IP"}},
+    "Action": {"Count": {}},
+    "VisibilityConfig": {
+      "SampledRequestsEnabled": false,
+      "MetricName": "friendly-rule-metric-name-1",
+      "CloudWatchMetricsEnabled": false
+    }
+  }
+]
+EOF
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, limit)
+}
+
 func testAccAwsWafv2WebACLImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
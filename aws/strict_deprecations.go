@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addStrictDeprecationsCustomizeDiff adds a CustomizeDiff function to the resource
+// that, when the provider's strict_deprecations argument is enabled, returns an error
+// for each configured deprecated argument instead of relying on Terraform's warning.
+// Resources without deprecated arguments are left unchanged.
+func addStrictDeprecationsCustomizeDiff(r *schema.Resource) {
+	if !schemaMapHasDeprecatedArgument(r.Schema) {
+		return
+	}
+
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = strictDeprecationsCustomizeDiff(r.Schema)
+		return
+	}
+
+	r.CustomizeDiff = customdiff.Sequence(
+		r.CustomizeDiff,
+		strictDeprecationsCustomizeDiff(r.Schema),
+	)
+}
+
+func strictDeprecationsCustomizeDiff(m map[string]*schema.Schema) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if client, ok := meta.(*AWSClient); !ok || !client.StrictDeprecations {
+			return nil
+		}
+
+		var errs *multierror.Error
+
+		for k, s := range m {
+			if !schemaHasDeprecatedArgument(s) {
+				continue
+			}
+
+			// The value of an Optional+Computed argument can come from prior state,
+			// so only report it when the configuration changes it.
+			if s.Computed && !diff.HasChange(k) {
+				continue
+			}
+
+			errs = multierror.Append(errs, deprecatedArgumentErrors(k, s, diff.Get(k))...)
+		}
+
+		return errs.ErrorOrNil()
+	}
+}
+
+func schemaMapHasDeprecatedArgument(m map[string]*schema.Schema) bool {
+	for _, s := range m {
+		if schemaHasDeprecatedArgument(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func schemaHasDeprecatedArgument(s *schema.Schema) bool {
+	if s.Deprecated != "" && (s.Optional || s.Required) {
+		return true
+	}
+
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		return schemaMapHasDeprecatedArgument(elem.Schema)
+	}
+
+	return false
+}
+
+// deprecatedArgumentErrors returns an error for the value at path, or any nested
+// block argument under it, that is deprecated and set to a non-zero value.
+func deprecatedArgumentErrors(path string, s *schema.Schema, v interface{}) []error {
+	if s.Deprecated != "" && (s.Optional || s.Required) {
+		if isZeroArgumentValue(v) {
+			return nil
+		}
+
+		return []error{fmt.Errorf("%s: argument is deprecated and strict_deprecations is enabled: %s", path, s.Deprecated)}
+	}
+
+	elem, ok := s.Elem.(*schema.Resource)
+
+	if !ok {
+		return nil
+	}
+
+	var items []interface{}
+
+	switch v := v.(type) {
+	case []interface{}:
+		items = v
+	case *schema.Set:
+		items = v.List()
+	}
+
+	var errs []error
+
+	for i, item := range items {
+		tfMap, ok := item.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for k, s := range elem.Schema {
+			errs = append(errs, deprecatedArgumentErrors(fmt.Sprintf("%s.%d.%s", path, i, k), s, tfMap[k])...)
+		}
+	}
+
+	return errs
+}
+
+func isZeroArgumentValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeprecatedArgumentErrors(t *testing.T) {
+	blockSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"current": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"legacy": {
+					Type:       schema.TypeString,
+					Optional:   true,
+					Deprecated: "use current instead",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		TestName       string
+		Schema         *schema.Schema
+		Value          interface{}
+		ExpectedErrors int
+	}{
+		{
+			TestName: "not deprecated",
+			Schema: &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			Value:          "value",
+			ExpectedErrors: 0,
+		},
+		{
+			TestName: "deprecated unset",
+			Schema: &schema.Schema{
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "use current instead",
+			},
+			Value:          "",
+			ExpectedErrors: 0,
+		},
+		{
+			TestName: "deprecated set",
+			Schema: &schema.Schema{
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "use current instead",
+			},
+			Value:          "value",
+			ExpectedErrors: 1,
+		},
+		{
+			TestName: "deprecated attribute",
+			Schema: &schema.Schema{
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "use current instead",
+			},
+			Value:          "value",
+			ExpectedErrors: 0,
+		},
+		{
+			TestName: "nested deprecated unset",
+			Schema:   blockSchema,
+			Value: []interface{}{
+				map[string]interface{}{
+					"current": "value",
+					"legacy":  "",
+				},
+			},
+			ExpectedErrors: 0,
+		},
+		{
+			TestName: "nested deprecated set",
+			Schema:   blockSchema,
+			Value: []interface{}{
+				map[string]interface{}{
+					"current": "",
+					"legacy":  "value",
+				},
+				map[string]interface{}{
+					"current": "",
+					"legacy":  "value",
+				},
+			},
+			ExpectedErrors: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := deprecatedArgumentErrors("test", testCase.Schema, testCase.Value)
+
+			if len(got) != testCase.ExpectedErrors {
+				t.Errorf("got %d errors (%v), expected %d", len(got), got, testCase.ExpectedErrors)
+			}
+		})
+	}
+}
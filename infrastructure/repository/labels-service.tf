@@ -15,6 +15,7 @@ variable "service_labels" {
     "apigatewayv2",
     "appconfig",
     "appflow",
+    "appintegrations",
     "applicationautoscaling",
     "applicationdiscoveryservice",
     "applicationinsights",
@@ -60,6 +61,7 @@ variable "service_labels" {
     "connect",
     "costandusagereportservice",
     "costexplorer",
+    "customerprofiles",
     "databasemigrationservice",
     "dataexchange",
     "datapipeline",
@@ -101,6 +103,7 @@ variable "service_labels" {
     "greengrass",
     "groundstation",
     "guardduty",
+    "healthlake",
     "honeycode",
     "iam",
     "identitystore",
@@ -123,6 +126,7 @@ variable "service_labels" {
     "lakeformation",
     "lambda",
     "lexmodelbuildingservice",
+    "lexmodelsv2",
     "licensemanager",
     "lightsail",
     "location",